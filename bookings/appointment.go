@@ -142,37 +142,38 @@ type BookAppointmentData struct {
 	Customer_Details CustomerDetails `url:"customer_details,json,omitempty"` // Note the option `json` before `omitempty`, the order shouldn't matter
 }
 
-//AppointmentResponse is the data returned by GetAppointment
+// AppointmentResponse is the data returned by GetAppointment, its times are given in TimeZone
+// without an offset, so they are decoded with the UTC location
 type AppointmentResponse struct {
 	Response struct {
 		ErrorMessage string   `json:"errormessage,omitempty"`
 		Status       string   `json:"status"`
 		LogMessage   []string `json:"logMessage"`
 		ReturnValue  struct {
-			StaffName                string   `json:"staff_name"`
-			CustomerMoreInfo         struct{} `json:"customer_more_info"`
-			CustomerBookingStartTime string   `json:"customer_booking_start_time"`
-			CustomerContactNo        string   `json:"customer_contact_no"`
-			BookedOn                 string   `json:"booked_on"`
-			BookingID                string   `json:"booking_id"`
-			WorkspaceId              string   `json:"workspace_id"`
-			Duration                 string   `json:"duration"`
-			ServiceId                string   `json:"service_id"`
-			StaffId                  string   `json:"staff_id"`
-			CostPaid                 string   `json:"cost_paid"`
-			Currency                 string   `json:"currency"`
-			WorkspaceName            string   `json:"workspace_name"`
-			Cost                     string   `json:"cost"`
-			ServiceName              string   `json:"service_name"`
-			TimeZone                 string   `json:"time_zone"`
-			StartTime                string   `json:"start_time"`
-			Due                      string   `json:"due"`
-			CustomerEmail            string   `json:"customer_email"`
-			BookingType              string   `json:"booking_type"`
-			CustomerName             string   `json:"customer_name"`
-			SummaryUrl               string   `json:"summary_url"`
-			CustomerBookingTimeZone  string   `json:"customer_booking_time_zone"`
-			Status                   string   `json:status"`
+			StaffName                string    `json:"staff_name"`
+			CustomerMoreInfo         struct{}  `json:"customer_more_info"`
+			CustomerBookingStartTime zoho.Time `json:"customer_booking_start_time"`
+			CustomerContactNo        string    `json:"customer_contact_no"`
+			BookedOn                 zoho.Time `json:"booked_on"`
+			BookingID                string    `json:"booking_id"`
+			WorkspaceId              string    `json:"workspace_id"`
+			Duration                 string    `json:"duration"`
+			ServiceId                string    `json:"service_id"`
+			StaffId                  string    `json:"staff_id"`
+			CostPaid                 string    `json:"cost_paid"`
+			Currency                 string    `json:"currency"`
+			WorkspaceName            string    `json:"workspace_name"`
+			Cost                     string    `json:"cost"`
+			ServiceName              string    `json:"service_name"`
+			TimeZone                 string    `json:"time_zone"`
+			StartTime                zoho.Time `json:"start_time"`
+			Due                      string    `json:"due"`
+			CustomerEmail            string    `json:"customer_email"`
+			BookingType              string    `json:"booking_type"`
+			CustomerName             string    `json:"customer_name"`
+			SummaryUrl               string    `json:"summary_url"`
+			CustomerBookingTimeZone  string    `json:"customer_booking_time_zone"`
+			Status                   string    `json:"status"`
 		} `json:"returnvalue"`
 	} `json:"response"`
 }
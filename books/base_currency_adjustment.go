@@ -1 +1,327 @@
 package books
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// ListBaseCurrencyAdjustments will return the list of base currency adjustments
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#list-base-currency-adjustment
+func (c *API) ListBaseCurrencyAdjustments(
+	params map[string]zoho.Parameter,
+) (data ListBaseCurrencyAdjustmentsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: BaseCurrencyAdjustmentModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s",
+			c.ZohoTLD,
+			BaseCurrencyAdjustmentModule,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListBaseCurrencyAdjustmentsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"filter_by":   "", // Date.All, Date.Today, Date.ThisWeek, Date.ThisMonth, Date.ThisQuarter, Date.ThisYear
+			"sort_column": "", // adjustment_date, exchange_rate, currency_code, debit_or_credit, gain_or_loss
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListBaseCurrencyAdjustmentsResponse{}, fmt.Errorf(
+			"Failed to retrieve base currency adjustments: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListBaseCurrencyAdjustmentsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve base currency adjustments: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListBaseCurrencyAdjustmentsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListBaseCurrencyAdjustmentsResponse'",
+	)
+}
+
+// GetBaseCurrencyAdjustment will return the base currency adjustment specified by id
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#get-base-currency-adjustment
+func (c *API) GetBaseCurrencyAdjustment(
+	id string,
+) (data BaseCurrencyAdjustmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: BaseCurrencyAdjustmentModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			BaseCurrencyAdjustmentModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &BaseCurrencyAdjustmentResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+			"Failed to retrieve base currency adjustment (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BaseCurrencyAdjustmentResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve base currency adjustment (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+		"Data retrieved was not 'BaseCurrencyAdjustmentResponse'",
+	)
+}
+
+// ListBaseCurrencyAdjustmentAccounts will return the accounts affected by adjusting the currency specified by currencyID
+// to the given exchange rate on the given date (yyyy-mm-dd).
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#list-account-details-for-base-currency-adjustment
+func (c *API) ListBaseCurrencyAdjustmentAccounts(
+	currencyID string,
+	adjustmentDate string,
+	exchangeRate float64,
+	notes string,
+) (data BaseCurrencyAdjustmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: BaseCurrencyAdjustmentModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/accounts",
+			c.ZohoTLD,
+			BaseCurrencyAdjustmentModule,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &BaseCurrencyAdjustmentResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"currency_id":     zoho.Parameter(currencyID),
+			"adjustment_date": zoho.Parameter(adjustmentDate),
+			"exchange_rate":   zoho.Parameter(strconv.FormatFloat(exchangeRate, 'f', -1, 64)),
+			"notes":           zoho.Parameter(notes),
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+			"Failed to retrieve accounts for base currency adjustment: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BaseCurrencyAdjustmentResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve accounts for base currency adjustment: %s",
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+		"Data retrieved was not 'BaseCurrencyAdjustmentResponse'",
+	)
+}
+
+// CreateBaseCurrencyAdjustment will adjust the base currency value of the accounts specified by accountIDs
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#create-a-base-currency-adjustment
+func (c *API) CreateBaseCurrencyAdjustment(
+	accountIDs []string,
+	request BaseCurrencyAdjustmentRequest,
+) (data BaseCurrencyAdjustmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: BaseCurrencyAdjustmentModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s",
+			c.ZohoTLD,
+			BaseCurrencyAdjustmentModule,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &BaseCurrencyAdjustmentResponse{},
+		RequestBody:  request,
+		URLParameters: map[string]zoho.Parameter{
+			"account_ids": zoho.Parameter(strings.Join(accountIDs, ",")),
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+			"Failed to create base currency adjustment: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BaseCurrencyAdjustmentResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create base currency adjustment: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+		"Data retrieved was not 'BaseCurrencyAdjustmentResponse'",
+	)
+}
+
+// DeleteBaseCurrencyAdjustment will delete the base currency adjustment specified by id
+// https://www.zoho.com/books/api/v3/base-currency-adjustment/#delete-a-base-currency-adjustment
+func (c *API) DeleteBaseCurrencyAdjustment(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: BaseCurrencyAdjustmentModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			BaseCurrencyAdjustmentModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to delete base currency adjustment (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to delete base currency adjustment (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// RunBaseCurrencyAdjustment will adjust every account affected by the change in exchange rate described by
+// request, by first listing the affected accounts and then creating the adjustment for all of them
+func (c *API) RunBaseCurrencyAdjustment(
+	request BaseCurrencyAdjustmentRequest,
+) (data BaseCurrencyAdjustmentResponse, err error) {
+	accounts, err := c.ListBaseCurrencyAdjustmentAccounts(
+		request.CurrencyID,
+		request.AdjustmentDate.String(),
+		request.ExchangeRate,
+		request.Notes,
+	)
+	if err != nil {
+		return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+			"Failed to run base currency adjustment: %s",
+			err,
+		)
+	}
+
+	accountIDs := make([]string, 0, len(accounts.Data.Accounts))
+	for _, account := range accounts.Data.Accounts {
+		accountIDs = append(accountIDs, account.AccountID)
+	}
+	if len(accountIDs) == 0 {
+		return BaseCurrencyAdjustmentResponse{}, fmt.Errorf(
+			"Failed to run base currency adjustment: no accounts affected",
+		)
+	}
+
+	data, err = c.CreateBaseCurrencyAdjustment(accountIDs, request)
+	if err != nil {
+		return data, fmt.Errorf("Failed to run base currency adjustment: %s", err)
+	}
+	return data, nil
+}
+
+// BaseCurrencyAdjustmentRequest is the data provided to CreateBaseCurrencyAdjustment
+type BaseCurrencyAdjustmentRequest struct {
+	CurrencyID     string    `json:"currency_id"`
+	AdjustmentDate zoho.Date `json:"adjustment_date"` // yyyy-mm-dd
+	ExchangeRate   float64   `json:"exchange_rate"`
+	Notes          string    `json:"notes"`
+}
+
+// BaseCurrencyAdjustmentAccount is an account affected by a base currency adjustment
+type BaseCurrencyAdjustmentAccount struct {
+	AccountID       string     `json:"account_id"`
+	AccountName     string     `json:"account_name"`
+	BcyBalance      zoho.Money `json:"bcy_balance"`
+	FcyBalance      zoho.Money `json:"fcy_balance"`
+	AdjustedBalance zoho.Money `json:"adjusted_balance"`
+	GainOrLoss      zoho.Money `json:"gain_or_loss"`
+	GlSpecificType  int64      `json:"gl_specific_type"`
+}
+
+// BaseCurrencyAdjustment is a base currency adjustment as returned by the Books API
+type BaseCurrencyAdjustment struct {
+	BaseCurrencyAdjustmentID string                          `json:"base_currency_adjustment_id"`
+	AdjustmentDate           zoho.Date                       `json:"adjustment_date"`
+	ExchangeRate             float64                         `json:"exchange_rate"`
+	CurrencyID               string                          `json:"currency_id"`
+	CurrencyCode             string                          `json:"currency_code"`
+	Notes                    string                          `json:"notes"`
+	GainOrLoss               zoho.Money                      `json:"gain_or_loss"`
+	Accounts                 []BaseCurrencyAdjustmentAccount `json:"accounts"`
+}
+
+// BaseCurrencyAdjustmentResponse is the data returned by CreateBaseCurrencyAdjustment, GetBaseCurrencyAdjustment
+// and ListBaseCurrencyAdjustmentAccounts
+type BaseCurrencyAdjustmentResponse struct {
+	Code    int64                  `json:"code"`
+	Message string                 `json:"message"`
+	Data    BaseCurrencyAdjustment `json:"data"`
+}
+
+// ListBaseCurrencyAdjustmentsResponse is the data returned by ListBaseCurrencyAdjustments
+type ListBaseCurrencyAdjustmentsResponse struct {
+	Code                    int64                    `json:"code"`
+	Message                 string                   `json:"message"`
+	BaseCurrencyAdjustments []BaseCurrencyAdjustment `json:"base_currency_adjustments"`
+	PageContext             PageContext              `json:"page_context"`
+}
@@ -1 +1,145 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateBill will create a new bill for a vendor
+// https://www.zoho.com/books/api/v3/bills/#create-a-bill
+func (c *API) CreateBill(request BillRequest) (data BillResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         BillsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, BillsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &BillResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BillResponse{}, fmt.Errorf("Failed to create bill: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BillResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create bill: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return BillResponse{}, fmt.Errorf("Data retrieved was not 'BillResponse'")
+}
+
+// GetBill will return the bill specified by id
+// https://www.zoho.com/books/api/v3/bills/#get-a-bill
+func (c *API) GetBill(id string) (data BillResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: BillsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			BillsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &BillResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BillResponse{}, fmt.Errorf("Failed to retrieve bill (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BillResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve bill (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return BillResponse{}, fmt.Errorf("Data retrieved was not 'BillResponse'")
+}
+
+// BillRequest is the data provided to CreateBill
+type BillRequest struct {
+	VendorID              string               `json:"vendor_id"`
+	CurrencyID            string               `json:"currency_id,omitempty"`
+	BillNumber            string               `json:"bill_number"`
+	ReferenceNumber       string               `json:"reference_number,omitempty"`
+	PurchaseorderIDs      []string             `json:"purchaseorder_ids,omitempty"`
+	GstTreatment          string               `json:"gst_treatment,omitempty"`
+	GstNo                 string               `json:"gst_no,omitempty"`
+	SourceOfSupply        string               `json:"source_of_supply,omitempty"`
+	DestinationOfSupply   string               `json:"destination_of_supply,omitempty"`
+	Date                  *zoho.Date           `json:"date,omitempty"`
+	DueDate               *zoho.Date           `json:"due_date,omitempty"`
+	PaymentTerms          int64                `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string               `json:"payment_terms_label,omitempty"`
+	ExchangeRate          float64              `json:"exchange_rate,omitempty"`
+	IsItemLevelTaxCalc    bool                 `json:"is_item_level_tax_calc,omitempty"`
+	IsInclusiveTax        bool                 `json:"is_inclusive_tax,omitempty"`
+	Discount              *float64             `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool                 `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string               `json:"discount_type,omitempty"`
+	Adjustment            *zoho.Money          `json:"adjustment,omitempty"`
+	AdjustmentDescription string               `json:"adjustment_description,omitempty"`
+	CustomFields          []CustomFieldRequest `json:"custom_fields,omitempty"`
+	LineItems             []LineItem           `json:"line_items"`
+	Notes                 string               `json:"notes,omitempty"`
+	Terms                 string               `json:"terms,omitempty"`
+}
+
+// Bill is a bill as returned by the Books API
+type Bill struct {
+	BillID                string        `json:"bill_id"`
+	BillNumber            string        `json:"bill_number"`
+	PurchaseorderIDs      []string      `json:"purchaseorder_ids"`
+	Date                  zoho.Date     `json:"date"`
+	DueDate               zoho.Date     `json:"due_date"`
+	ReferenceNumber       string        `json:"reference_number"`
+	Status                string        `json:"status"`
+	VendorID              string        `json:"vendor_id"`
+	VendorName            string        `json:"vendor_name"`
+	CurrencyID            string        `json:"currency_id"`
+	CurrencyCode          string        `json:"currency_code"`
+	ExchangeRate          float64       `json:"exchange_rate"`
+	PaymentTerms          int64         `json:"payment_terms"`
+	PaymentTermsLabel     string        `json:"payment_terms_label"`
+	IsInclusiveTax        bool          `json:"is_inclusive_tax"`
+	Discount              float64       `json:"discount"`
+	IsDiscountBeforeTax   bool          `json:"is_discount_before_tax"`
+	DiscountType          string        `json:"discount_type"`
+	LineItems             []LineItem    `json:"line_items"`
+	Adjustment            zoho.Money    `json:"adjustment"`
+	AdjustmentDescription string        `json:"adjustment_description"`
+	SubTotal              zoho.Money    `json:"sub_total"`
+	TaxTotal              zoho.Money    `json:"tax_total"`
+	Total                 zoho.Money    `json:"total"`
+	Taxes                 []TaxSummary  `json:"taxes"`
+	PaymentMade           zoho.Money    `json:"payment_made"`
+	VendorCreditsApplied  zoho.Money    `json:"vendor_credits_applied"`
+	Balance               zoho.Money    `json:"balance"`
+	BillingAddress        Address       `json:"billing_address"`
+	Notes                 string        `json:"notes"`
+	Terms                 string        `json:"terms"`
+	CustomFields          []CustomField `json:"custom_fields"`
+	CreatedTime           zoho.Time     `json:"created_time"`
+	LastModifiedTime      zoho.Time     `json:"last_modified_time"`
+}
+
+// BillResponse is the data returned by CreateBill and GetBill
+type BillResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+	Bill    Bill   `json:"bill"`
+}
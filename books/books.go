@@ -7,6 +7,41 @@ import (
 	zoho "github.com/schmorrison/Zoho"
 )
 
+// Change here only if these values changes over time
+const (
+	BooksAPIEndpointHeader       string = "X-com-zoho-books-organizationid"
+	EstimatesModule              string = "estimates"
+	SalesOrdersModule            string = "salesorders"
+	InvoicesModule               string = "invoices"
+	PurchaseOrdersModule         string = "purchaseorders"
+	VendorCreditsModule          string = "vendorcredits"
+	BillsModule                  string = "bills"
+	CustomerPaymentsModule       string = "customerpayments"
+	CreditNotesModule            string = "creditnotes"
+	ItemsModule                  string = "items"
+	TaxesModule                  string = "settings/taxes"
+	TaxGroupsModule              string = "settings/taxgroups"
+	TaxAuthoritiesModule         string = "settings/taxauthorities"
+	TaxExemptionsModule          string = "settings/taxexemptions"
+	CurrenciesModule             string = "settings/currencies"
+	ExpensesModule               string = "expenses"
+	RecurringExpensesModule      string = "recurringexpenses"
+	RecurringInvoicesModule      string = "recurringinvoices"
+	RetainerInvoicesModule       string = "retainerinvoices"
+	ProjectsModule               string = "projects"
+	TimeEntriesModule            string = "projects/timeentries"
+	OpeningBalancesModule        string = "settings/openingbalances"
+	BaseCurrencyAdjustmentModule string = "basecurrencyadjustment"
+	UsersModule                  string = "users"
+	OrganizationsModule          string = "organizations"
+	PreferencesModule            string = "settings/preferences"
+	InvoiceSettingsModule        string = "settings/invoices"
+	EstimateSettingsModule       string = "settings/estimates"
+	PaymentTermsModule           string = "settings/paymentterms"
+	CustomFieldsModule           string = "settings/customfields"
+	ReportsModule                string = "reports"
+)
+
 // API is used for interacting with the Zoho Books API
 type API struct {
 	*zoho.Zoho
@@ -1 +1,483 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateCreditNote will create a new credit note for a customer
+// https://www.zoho.com/books/api/v3/credit-notes/#create-a-credit-note
+func (c *API) CreateCreditNote(request CreditNoteRequest) (data CreditNoteResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         CreditNotesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, CreditNotesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &CreditNoteResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CreditNoteResponse{}, fmt.Errorf("Failed to create credit note: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNoteResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create credit note: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return CreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'CreditNoteResponse'")
+}
+
+// GetCreditNote will return the credit note specified by id
+// https://www.zoho.com/books/api/v3/credit-notes/#get-a-credit-note
+func (c *API) GetCreditNote(id string) (data CreditNoteResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CreditNotesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CreditNotesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &CreditNoteResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CreditNoteResponse{}, fmt.Errorf("Failed to retrieve credit note (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNoteResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve credit note (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'CreditNoteResponse'")
+}
+
+// ListCreditNotes will return the list of credit notes matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/credit-notes/#list-all-credit-notes
+func (c *API) ListCreditNotes(
+	params map[string]zoho.Parameter,
+) (data ListCreditNotesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         CreditNotesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, CreditNotesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListCreditNotesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"creditnote_number": "",
+			"customer_id":       "",
+			"status":            "", // open, closed, void, draft
+			"filter_by":         "",
+			"search_text":       "",
+			"sort_column":       "",
+			"page":              "1",
+			"per_page":          "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListCreditNotesResponse{}, fmt.Errorf("Failed to retrieve credit notes: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListCreditNotesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve credit notes: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListCreditNotesResponse{}, fmt.Errorf("Data retrieved was not 'ListCreditNotesResponse'")
+}
+
+// UpdateCreditNote will modify the credit note specified by id
+// https://www.zoho.com/books/api/v3/credit-notes/#update-a-credit-note
+func (c *API) UpdateCreditNote(
+	id string,
+	request CreditNoteRequest,
+) (data CreditNoteResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CreditNotesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CreditNotesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &CreditNoteResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CreditNoteResponse{}, fmt.Errorf("Failed to update credit note (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNoteResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update credit note (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'CreditNoteResponse'")
+}
+
+// DeleteCreditNote will delete the credit note specified by id
+// https://www.zoho.com/books/api/v3/credit-notes/#delete-a-credit-note
+func (c *API) DeleteCreditNote(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CreditNotesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CreditNotesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete credit note (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete credit note (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// VoidCreditNote will mark the credit note specified by id as void
+// https://www.zoho.com/books/api/v3/credit-notes/#void-a-credit-note
+func (c *API) VoidCreditNote(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CreditNotesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/void",
+			c.ZohoTLD,
+			CreditNotesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to void credit note (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to void credit note (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ApplyCreditNoteToInvoices will apply the credit note specified by id to one or more invoices
+// https://www.zoho.com/books/api/v3/credit-notes/#credit-to-an-invoice
+func (c *API) ApplyCreditNoteToInvoices(
+	id string,
+	request ApplyCreditNoteRequest,
+) (data ApplyCreditNoteResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CreditNotesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/invoices",
+			c.ZohoTLD,
+			CreditNotesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ApplyCreditNoteResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ApplyCreditNoteResponse{}, fmt.Errorf(
+			"Failed to apply credit note (%s) to invoices: %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ApplyCreditNoteResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to apply credit note (%s) to invoices: %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ApplyCreditNoteResponse{}, fmt.Errorf("Data retrieved was not 'ApplyCreditNoteResponse'")
+}
+
+// RefundCreditNote will refund the remaining credit of the credit note specified by id to the customer
+// https://www.zoho.com/books/api/v3/credit-notes/#refund-credit-note
+func (c *API) RefundCreditNote(
+	id string,
+	request RefundRequest,
+) (data CreditNoteRefundResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CreditNotesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/refunds",
+			c.ZohoTLD,
+			CreditNotesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &CreditNoteRefundResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CreditNoteRefundResponse{}, fmt.Errorf(
+			"Failed to refund credit note (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CreditNoteRefundResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to refund credit note (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CreditNoteRefundResponse{}, fmt.Errorf(
+		"Data retrieved was not 'CreditNoteRefundResponse'",
+	)
+}
+
+// ListCreditNoteRefunds will return the refunds of the credit note specified by id
+// https://www.zoho.com/books/api/v3/credit-notes/#list-refunds-of-a-credit-note
+func (c *API) ListCreditNoteRefunds(id string) (data ListCreditNoteRefundsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CreditNotesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/refunds",
+			c.ZohoTLD,
+			CreditNotesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListCreditNoteRefundsResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListCreditNoteRefundsResponse{}, fmt.Errorf(
+			"Failed to retrieve refunds of credit note (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListCreditNoteRefundsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve refunds of credit note (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ListCreditNoteRefundsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListCreditNoteRefundsResponse'",
+	)
+}
+
+// CreditNoteRequest is the data provided to CreateCreditNote and UpdateCreditNote
+type CreditNoteRequest struct {
+	CustomerID       string               `json:"customer_id"`
+	ContactPersons   []string             `json:"contact_persons,omitempty"`
+	CurrencyID       string               `json:"currency_id,omitempty"`
+	Date             zoho.Date            `json:"date"`
+	CreditnoteNumber string               `json:"creditnote_number,omitempty"`
+	ReferenceNumber  string               `json:"reference_number,omitempty"`
+	InvoiceID        string               `json:"invoice_id,omitempty"`
+	PlaceOfSupply    string               `json:"place_of_supply,omitempty"`
+	GstTreatment     string               `json:"gst_treatment,omitempty"`
+	GstNo            string               `json:"gst_no,omitempty"`
+	TemplateID       string               `json:"template_id,omitempty"`
+	ExchangeRate     float64              `json:"exchange_rate,omitempty"`
+	IsInclusiveTax   bool                 `json:"is_inclusive_tax,omitempty"`
+	LineItems        []LineItem           `json:"line_items"`
+	Notes            string               `json:"notes,omitempty"`
+	Terms            string               `json:"terms,omitempty"`
+	CustomFields     []CustomFieldRequest `json:"custom_fields,omitempty"`
+}
+
+// CreditNote is a credit note as returned by the Books API
+type CreditNote struct {
+	CreditnoteID        string       `json:"creditnote_id"`
+	CreditnoteNumber    string       `json:"creditnote_number"`
+	Date                zoho.Date    `json:"date"`
+	Status              string       `json:"status"`
+	ReferenceNumber     string       `json:"reference_number"`
+	CustomerID          string       `json:"customer_id"`
+	CustomerName        string       `json:"customer_name"`
+	ContactPersons      []string     `json:"contact_persons"`
+	CurrencyID          string       `json:"currency_id"`
+	CurrencyCode        string       `json:"currency_code"`
+	ExchangeRate        float64      `json:"exchange_rate"`
+	IsInclusiveTax      bool         `json:"is_inclusive_tax"`
+	LineItems           []LineItem   `json:"line_items"`
+	SubTotal            zoho.Money   `json:"sub_total"`
+	Total               zoho.Money   `json:"total"`
+	TotalCreditsUsed    zoho.Money   `json:"total_credits_used"`
+	TotalRefundedAmount zoho.Money   `json:"total_refunded_amount"`
+	Balance             zoho.Money   `json:"balance"`
+	Taxes               []TaxSummary `json:"taxes"`
+	InvoicesCredited    []struct {
+		CreditnoteID        string     `json:"creditnote_id"`
+		InvoiceID           string     `json:"invoice_id"`
+		InvoiceNumber       string     `json:"invoice_number"`
+		CreditnoteInvoiceID string     `json:"creditnote_invoice_id"`
+		Date                zoho.Date  `json:"date"`
+		AmountApplied       zoho.Money `json:"amount_applied"`
+	} `json:"invoices_credited"`
+	BillingAddress   Address       `json:"billing_address"`
+	ShippingAddress  Address       `json:"shipping_address"`
+	Notes            string        `json:"notes"`
+	Terms            string        `json:"terms"`
+	CustomFields     []CustomField `json:"custom_fields"`
+	TemplateID       string        `json:"template_id"`
+	TemplateName     string        `json:"template_name"`
+	CreatedTime      zoho.Time     `json:"created_time"`
+	LastModifiedTime zoho.Time     `json:"last_modified_time"`
+}
+
+// CreditNoteResponse is the data returned by CreateCreditNote, GetCreditNote and UpdateCreditNote
+type CreditNoteResponse struct {
+	Code       int64      `json:"code"`
+	Message    string     `json:"message"`
+	CreditNote CreditNote `json:"creditnote"`
+}
+
+// ListCreditNotesResponse is the data returned by ListCreditNotes
+type ListCreditNotesResponse struct {
+	Code        int64  `json:"code"`
+	Message     string `json:"message"`
+	CreditNotes []struct {
+		CreditnoteID     string     `json:"creditnote_id"`
+		CreditnoteNumber string     `json:"creditnote_number"`
+		Status           string     `json:"status"`
+		ReferenceNumber  string     `json:"reference_number"`
+		Date             zoho.Date  `json:"date"`
+		Total            zoho.Money `json:"total"`
+		Balance          zoho.Money `json:"balance"`
+		CustomerID       string     `json:"customer_id"`
+		CustomerName     string     `json:"customer_name"`
+		CurrencyID       string     `json:"currency_id"`
+		CurrencyCode     string     `json:"currency_code"`
+		CreatedTime      zoho.Time  `json:"created_time"`
+		LastModifiedTime zoho.Time  `json:"last_modified_time"`
+	} `json:"creditnotes"`
+	PageContext PageContext `json:"page_context"`
+}
+
+// ApplyCreditNoteRequest is the data provided to ApplyCreditNoteToInvoices
+type ApplyCreditNoteRequest struct {
+	Invoices []InvoiceCredit `json:"invoices"`
+}
+
+// InvoiceCredit is the amount of a credit note applied to a single invoice
+type InvoiceCredit struct {
+	InvoiceID     string     `json:"invoice_id"`
+	AmountApplied zoho.Money `json:"amount_applied"`
+}
+
+// ApplyCreditNoteResponse is the data returned by ApplyCreditNoteToInvoices.
+// The balance of each credited invoice is included when returned by Zoho.
+type ApplyCreditNoteResponse struct {
+	Code            int64  `json:"code"`
+	Message         string `json:"message"`
+	ApplyToInvoices struct {
+		Invoices []struct {
+			InvoiceID     string     `json:"invoice_id"`
+			InvoiceNumber string     `json:"invoice_number"`
+			AmountApplied zoho.Money `json:"amount_applied"`
+			Balance       zoho.Money `json:"balance"`
+		} `json:"invoices"`
+	} `json:"apply_to_invoices"`
+}
+
+// CreditNoteRefundResponse is the data returned by RefundCreditNote
+type CreditNoteRefundResponse struct {
+	Code             int64  `json:"code"`
+	Message          string `json:"message"`
+	CreditnoteRefund Refund `json:"creditnote_refund"`
+}
+
+// ListCreditNoteRefundsResponse is the data returned by ListCreditNoteRefunds
+type ListCreditNoteRefundsResponse struct {
+	Code              int64       `json:"code"`
+	Message           string      `json:"message"`
+	CreditnoteRefunds []Refund    `json:"creditnote_refunds"`
+	PageContext       PageContext `json:"page_context"`
+}
@@ -1 +1,487 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateCurrency will add a new currency to the organization
+// https://www.zoho.com/books/api/v3/currency/#create-a-currency
+func (c *API) CreateCurrency(request CurrencyRequest) (data CurrencyResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         CurrenciesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, CurrenciesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &CurrencyResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CurrencyResponse{}, fmt.Errorf("Failed to create currency: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CurrencyResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create currency: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return CurrencyResponse{}, fmt.Errorf("Data retrieved was not 'CurrencyResponse'")
+}
+
+// GetCurrency will return the currency specified by id
+// https://www.zoho.com/books/api/v3/currency/#get-a-currency
+func (c *API) GetCurrency(id string) (data CurrencyResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CurrenciesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &CurrencyResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CurrencyResponse{}, fmt.Errorf("Failed to retrieve currency (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CurrencyResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve currency (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CurrencyResponse{}, fmt.Errorf("Data retrieved was not 'CurrencyResponse'")
+}
+
+// ListCurrencies will return the list of currencies configured for the organization
+// https://www.zoho.com/books/api/v3/currency/#list-currencies
+func (c *API) ListCurrencies(
+	params map[string]zoho.Parameter,
+) (data ListCurrenciesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         CurrenciesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, CurrenciesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListCurrenciesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"filter_by": "", // Currencies.ExcludeBaseCurrency
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListCurrenciesResponse{}, fmt.Errorf("Failed to retrieve currencies: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListCurrenciesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve currencies: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListCurrenciesResponse{}, fmt.Errorf("Data retrieved was not 'ListCurrenciesResponse'")
+}
+
+// UpdateCurrency will modify the currency specified by id
+// https://www.zoho.com/books/api/v3/currency/#update-a-currency
+func (c *API) UpdateCurrency(
+	id string,
+	request CurrencyRequest,
+) (data CurrencyResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CurrenciesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &CurrencyResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CurrencyResponse{}, fmt.Errorf("Failed to update currency (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CurrencyResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update currency (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CurrencyResponse{}, fmt.Errorf("Data retrieved was not 'CurrencyResponse'")
+}
+
+// DeleteCurrency will remove the currency specified by id from the organization
+// https://www.zoho.com/books/api/v3/currency/#delete-a-currency
+func (c *API) DeleteCurrency(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CurrenciesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete currency (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete currency (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// CreateExchangeRate will add an exchange rate to the currency specified by currencyID
+// https://www.zoho.com/books/api/v3/currency/#create-an-exchange-rate
+func (c *API) CreateExchangeRate(
+	currencyID string,
+	request ExchangeRateRequest,
+) (data ExchangeRateResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/exchangerates",
+			c.ZohoTLD,
+			CurrenciesModule,
+			currencyID,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ExchangeRateResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ExchangeRateResponse{}, fmt.Errorf(
+			"Failed to create exchange rate for currency (%s): %s",
+			currencyID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExchangeRateResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to create exchange rate for currency (%s): %s",
+				currencyID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ExchangeRateResponse{}, fmt.Errorf("Data retrieved was not 'ExchangeRateResponse'")
+}
+
+// GetExchangeRate will return a single exchange rate of the currency specified by currencyID
+// https://www.zoho.com/books/api/v3/currency/#get-an-exchange-rate
+func (c *API) GetExchangeRate(currencyID string, id string) (data ExchangeRateResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/exchangerates/%s",
+			c.ZohoTLD,
+			CurrenciesModule,
+			currencyID,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ExchangeRateResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ExchangeRateResponse{}, fmt.Errorf(
+			"Failed to retrieve exchange rate (%s) for currency (%s): %s",
+			id,
+			currencyID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExchangeRateResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve exchange rate (%s) for currency (%s): %s",
+				id,
+				currencyID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ExchangeRateResponse{}, fmt.Errorf("Data retrieved was not 'ExchangeRateResponse'")
+}
+
+// ListExchangeRates will return the exchange rates of the currency specified by currencyID
+// https://www.zoho.com/books/api/v3/currency/#list-exchange-rates
+func (c *API) ListExchangeRates(
+	currencyID string,
+	params map[string]zoho.Parameter,
+) (data ListExchangeRatesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/exchangerates",
+			c.ZohoTLD,
+			CurrenciesModule,
+			currencyID,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListExchangeRatesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"from_date":       "", // yyyy-mm-dd
+			"is_current_date": "",
+			"sort_column":     "",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListExchangeRatesResponse{}, fmt.Errorf(
+			"Failed to retrieve exchange rates for currency (%s): %s",
+			currencyID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListExchangeRatesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve exchange rates for currency (%s): %s",
+				currencyID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ListExchangeRatesResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListExchangeRatesResponse'",
+	)
+}
+
+// UpdateExchangeRate will modify a single exchange rate of the currency specified by currencyID
+// https://www.zoho.com/books/api/v3/currency/#update-an-exchange-rate
+func (c *API) UpdateExchangeRate(
+	currencyID string,
+	id string,
+	request ExchangeRateRequest,
+) (data ExchangeRateResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/exchangerates/%s",
+			c.ZohoTLD,
+			CurrenciesModule,
+			currencyID,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &ExchangeRateResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ExchangeRateResponse{}, fmt.Errorf(
+			"Failed to update exchange rate (%s) for currency (%s): %s",
+			id,
+			currencyID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExchangeRateResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to update exchange rate (%s) for currency (%s): %s",
+				id,
+				currencyID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ExchangeRateResponse{}, fmt.Errorf("Data retrieved was not 'ExchangeRateResponse'")
+}
+
+// DeleteExchangeRate will delete a single exchange rate of the currency specified by currencyID
+// https://www.zoho.com/books/api/v3/currency/#delete-an-exchange-rate
+func (c *API) DeleteExchangeRate(currencyID string, id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CurrenciesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/exchangerates/%s",
+			c.ZohoTLD,
+			CurrenciesModule,
+			currencyID,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to delete exchange rate (%s) for currency (%s): %s",
+			id,
+			currencyID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to delete exchange rate (%s) for currency (%s): %s",
+				id,
+				currencyID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// CurrencyRequest is the data provided to CreateCurrency and UpdateCurrency
+type CurrencyRequest struct {
+	CurrencyCode   string `json:"currency_code"`
+	CurrencySymbol string `json:"currency_symbol"`
+	PricePrecision int64  `json:"price_precision,omitempty"`
+	CurrencyFormat string `json:"currency_format,omitempty"`
+}
+
+// Currency is a currency as returned by the Books API
+type Currency struct {
+	CurrencyID     string    `json:"currency_id"`
+	CurrencyCode   string    `json:"currency_code"`
+	CurrencyName   string    `json:"currency_name"`
+	CurrencySymbol string    `json:"currency_symbol"`
+	PricePrecision int64     `json:"price_precision"`
+	CurrencyFormat string    `json:"currency_format"`
+	IsBaseCurrency bool      `json:"is_base_currency"`
+	ExchangeRate   float64   `json:"exchange_rate"`
+	EffectiveDate  zoho.Date `json:"effective_date"`
+}
+
+// CurrencyResponse is the data returned by CreateCurrency, GetCurrency and UpdateCurrency
+type CurrencyResponse struct {
+	Code     int64    `json:"code"`
+	Message  string   `json:"message"`
+	Currency Currency `json:"currency"`
+}
+
+// ListCurrenciesResponse is the data returned by ListCurrencies
+type ListCurrenciesResponse struct {
+	Code       int64      `json:"code"`
+	Message    string     `json:"message"`
+	Currencies []Currency `json:"currencies"`
+}
+
+// ExchangeRateRequest is the data provided to CreateExchangeRate and UpdateExchangeRate
+type ExchangeRateRequest struct {
+	EffectiveDate zoho.Date `json:"effective_date"` // yyyy-mm-dd
+	Rate          float64   `json:"rate"`
+}
+
+// ExchangeRate is the rate of a currency relative to the base currency from a given date
+type ExchangeRate struct {
+	ExchangeRateID string    `json:"exchange_rate_id"`
+	CurrencyID     string    `json:"currency_id"`
+	CurrencyCode   string    `json:"currency_code"`
+	EffectiveDate  zoho.Date `json:"effective_date"`
+	Rate           float64   `json:"rate"`
+}
+
+// ExchangeRateResponse is the data returned by CreateExchangeRate, GetExchangeRate and UpdateExchangeRate
+type ExchangeRateResponse struct {
+	Code         int64        `json:"code"`
+	Message      string       `json:"message"`
+	ExchangeRate ExchangeRate `json:"exchange_rate"`
+}
+
+// ListExchangeRatesResponse is the data returned by ListExchangeRates
+type ListExchangeRatesResponse struct {
+	Code          int64          `json:"code"`
+	Message       string         `json:"message"`
+	ExchangeRates []ExchangeRate `json:"exchange_rates"`
+}
@@ -1 +1,404 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateCustomerPayment will record a payment from a customer.
+// The payment can be applied across multiple invoices of the same customer.
+// https://www.zoho.com/books/api/v3/customer-payments/#create-a-payment
+func (c *API) CreateCustomerPayment(
+	request CustomerPaymentRequest,
+) (data CustomerPaymentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         CustomerPaymentsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, CustomerPaymentsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &CustomerPaymentResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CustomerPaymentResponse{}, fmt.Errorf("Failed to create customer payment: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create customer payment: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return CustomerPaymentResponse{}, fmt.Errorf("Data retrieved was not 'CustomerPaymentResponse'")
+}
+
+// GetCustomerPayment will return the customer payment specified by id
+// https://www.zoho.com/books/api/v3/customer-payments/#retrieve-a-payment
+func (c *API) GetCustomerPayment(id string) (data CustomerPaymentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CustomerPaymentsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CustomerPaymentsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &CustomerPaymentResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CustomerPaymentResponse{}, fmt.Errorf(
+			"Failed to retrieve customer payment (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve customer payment (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CustomerPaymentResponse{}, fmt.Errorf("Data retrieved was not 'CustomerPaymentResponse'")
+}
+
+// ListCustomerPayments will return the list of customer payments matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/customer-payments/#list-customer-payments
+func (c *API) ListCustomerPayments(
+	params map[string]zoho.Parameter,
+) (data ListCustomerPaymentsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         CustomerPaymentsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, CustomerPaymentsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListCustomerPaymentsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"customer_id":      "",
+			"reference_number": "",
+			"payment_mode":     "",
+			"filter_by":        "", // PaymentMode.All, PaymentMode.Check, PaymentMode.Cash, PaymentMode.BankTransfer, ...
+			"search_text":      "",
+			"sort_column":      "",
+			"page":             "1",
+			"per_page":         "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListCustomerPaymentsResponse{}, fmt.Errorf(
+			"Failed to retrieve customer payments: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListCustomerPaymentsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve customer payments: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListCustomerPaymentsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListCustomerPaymentsResponse'",
+	)
+}
+
+// UpdateCustomerPayment will modify the customer payment specified by id
+// https://www.zoho.com/books/api/v3/customer-payments/#update-a-payment
+func (c *API) UpdateCustomerPayment(
+	id string,
+	request CustomerPaymentRequest,
+) (data CustomerPaymentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CustomerPaymentsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CustomerPaymentsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &CustomerPaymentResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CustomerPaymentResponse{}, fmt.Errorf(
+			"Failed to update customer payment (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update customer payment (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CustomerPaymentResponse{}, fmt.Errorf("Data retrieved was not 'CustomerPaymentResponse'")
+}
+
+// DeleteCustomerPayment will delete the customer payment specified by id
+// https://www.zoho.com/books/api/v3/customer-payments/#delete-a-payment
+func (c *API) DeleteCustomerPayment(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CustomerPaymentsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			CustomerPaymentsModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete customer payment (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete customer payment (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// RefundCustomerPayment will refund the excess amount of the customer payment specified by id
+// https://www.zoho.com/books/api/v3/customer-payments/#refund-an-excess-customer-payment
+func (c *API) RefundCustomerPayment(
+	id string,
+	request RefundRequest,
+) (data CustomerPaymentRefundResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CustomerPaymentsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/refunds",
+			c.ZohoTLD,
+			CustomerPaymentsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &CustomerPaymentRefundResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CustomerPaymentRefundResponse{}, fmt.Errorf(
+			"Failed to refund customer payment (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomerPaymentRefundResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to refund customer payment (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return CustomerPaymentRefundResponse{}, fmt.Errorf(
+		"Data retrieved was not 'CustomerPaymentRefundResponse'",
+	)
+}
+
+// ListCustomerPaymentRefunds will return the refunds of the customer payment specified by id
+// https://www.zoho.com/books/api/v3/customer-payments/#list-refunds-of-a-customer-payment
+func (c *API) ListCustomerPaymentRefunds(
+	id string,
+) (data ListCustomerPaymentRefundsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: CustomerPaymentsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/refunds",
+			c.ZohoTLD,
+			CustomerPaymentsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListCustomerPaymentRefundsResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListCustomerPaymentRefundsResponse{}, fmt.Errorf(
+			"Failed to retrieve refunds of customer payment (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListCustomerPaymentRefundsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve refunds of customer payment (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ListCustomerPaymentRefundsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListCustomerPaymentRefundsResponse'",
+	)
+}
+
+// CustomerPaymentRequest is the data provided to CreateCustomerPayment and UpdateCustomerPayment
+type CustomerPaymentRequest struct {
+	CustomerID      string               `json:"customer_id"`
+	PaymentMode     string               `json:"payment_mode"`
+	Amount          zoho.Money           `json:"amount"`
+	Date            zoho.Date            `json:"date"`
+	ReferenceNumber string               `json:"reference_number,omitempty"`
+	Description     string               `json:"description,omitempty"`
+	Invoices        []InvoicePayment     `json:"invoices"`
+	ExchangeRate    float64              `json:"exchange_rate,omitempty"`
+	BankCharges     *zoho.Money          `json:"bank_charges,omitempty"`
+	AccountID       string               `json:"account_id,omitempty"`
+	TaxAccountID    string               `json:"tax_account_id,omitempty"`
+	CustomFields    []CustomFieldRequest `json:"custom_fields,omitempty"`
+}
+
+// InvoicePayment is the portion of a payment applied to a single invoice
+type InvoicePayment struct {
+	InvoiceID         string      `json:"invoice_id"`
+	AmountApplied     zoho.Money  `json:"amount_applied"`
+	TaxAmountWithheld *zoho.Money `json:"tax_amount_withheld,omitempty"`
+}
+
+// CustomerPayment is a customer payment as returned by the Books API
+type CustomerPayment struct {
+	PaymentID         string     `json:"payment_id"`
+	PaymentNumber     string     `json:"payment_number"`
+	PaymentMode       string     `json:"payment_mode"`
+	Amount            zoho.Money `json:"amount"`
+	AmountRefunded    zoho.Money `json:"amount_refunded"`
+	UnusedAmount      zoho.Money `json:"unused_amount"`
+	BankCharges       zoho.Money `json:"bank_charges"`
+	TaxAmountWithheld zoho.Money `json:"tax_amount_withheld"`
+	Date              zoho.Date  `json:"date"`
+	Status            string     `json:"status"`
+	ReferenceNumber   string     `json:"reference_number"`
+	Description       string     `json:"description"`
+	CustomerID        string     `json:"customer_id"`
+	CustomerName      string     `json:"customer_name"`
+	Email             string     `json:"email"`
+	AccountID         string     `json:"account_id"`
+	AccountName       string     `json:"account_name"`
+	CurrencyID        string     `json:"currency_id"`
+	CurrencyCode      string     `json:"currency_code"`
+	CurrencySymbol    string     `json:"currency_symbol"`
+	ExchangeRate      float64    `json:"exchange_rate"`
+	Invoices          []struct {
+		InvoiceID         string     `json:"invoice_id"`
+		InvoicePaymentID  string     `json:"invoice_payment_id"`
+		InvoiceNumber     string     `json:"invoice_number"`
+		Date              zoho.Date  `json:"date"`
+		DueDate           zoho.Date  `json:"due_date"`
+		InvoiceAmount     zoho.Money `json:"invoice_amount"`
+		AmountApplied     zoho.Money `json:"amount_applied"`
+		TaxAmountWithheld zoho.Money `json:"tax_amount_withheld"`
+		BalanceAmount     zoho.Money `json:"balance_amount"`
+	} `json:"invoices"`
+	CustomFields     []CustomField `json:"custom_fields"`
+	CreatedTime      zoho.Time     `json:"created_time"`
+	LastModifiedTime zoho.Time     `json:"last_modified_time"`
+}
+
+// CustomerPaymentResponse is the data returned by CreateCustomerPayment, GetCustomerPayment and UpdateCustomerPayment
+type CustomerPaymentResponse struct {
+	Code    int64           `json:"code"`
+	Message string          `json:"message"`
+	Payment CustomerPayment `json:"payment"`
+}
+
+// ListCustomerPaymentsResponse is the data returned by ListCustomerPayments
+type ListCustomerPaymentsResponse struct {
+	Code             int64  `json:"code"`
+	Message          string `json:"message"`
+	CustomerPayments []struct {
+		PaymentID        string     `json:"payment_id"`
+		PaymentNumber    string     `json:"payment_number"`
+		InvoiceNumbers   string     `json:"invoice_numbers"`
+		CustomerID       string     `json:"customer_id"`
+		CustomerName     string     `json:"customer_name"`
+		PaymentMode      string     `json:"payment_mode"`
+		Date             zoho.Date  `json:"date"`
+		ReferenceNumber  string     `json:"reference_number"`
+		Amount           zoho.Money `json:"amount"`
+		BcyAmount        zoho.Money `json:"bcy_amount"`
+		UnusedAmount     zoho.Money `json:"unused_amount"`
+		BcyUnusedAmount  zoho.Money `json:"bcy_unused_amount"`
+		AccountID        string     `json:"account_id"`
+		AccountName      string     `json:"account_name"`
+		Description      string     `json:"description"`
+		CreatedTime      zoho.Time  `json:"created_time"`
+		LastModifiedTime zoho.Time  `json:"last_modified_time"`
+	} `json:"customerpayments"`
+	PageContext PageContext `json:"page_context"`
+}
+
+// CustomerPaymentRefundResponse is the data returned by RefundCustomerPayment
+type CustomerPaymentRefundResponse struct {
+	Code          int64  `json:"code"`
+	Message       string `json:"message"`
+	PaymentRefund Refund `json:"payment_refund"`
+}
+
+// ListCustomerPaymentRefundsResponse is the data returned by ListCustomerPaymentRefunds
+type ListCustomerPaymentRefundsResponse struct {
+	Code           int64       `json:"code"`
+	Message        string      `json:"message"`
+	PaymentRefunds []Refund    `json:"payment_refunds"`
+	PageContext    PageContext `json:"page_context"`
+}
@@ -1 +1,547 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+	"github.com/schmorrison/Zoho/finance"
+)
+
+// EstimateStatus is a status that an estimate can be moved to
+type EstimateStatus string
+
+// Proper names for Estimate status transitions
+const (
+	EstimateStatusSent     EstimateStatus = "sent"
+	EstimateStatusAccepted EstimateStatus = "accepted"
+	EstimateStatusDeclined EstimateStatus = "declined"
+)
+
+// CreateEstimate will create a new estimate for a customer
+// https://www.zoho.com/books/api/v3/estimates/#create-an-estimate
+func (c *API) CreateEstimate(request EstimateRequest) (data EstimateResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         EstimatesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, EstimatesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &EstimateResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return EstimateResponse{}, fmt.Errorf("Failed to create estimate: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create estimate: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return EstimateResponse{}, fmt.Errorf("Data retrieved was not 'EstimateResponse'")
+}
+
+// GetEstimate will return the estimate specified by id
+// https://www.zoho.com/books/api/v3/estimates/#get-an-estimate
+func (c *API) GetEstimate(id string) (data EstimateResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: EstimatesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			EstimatesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &EstimateResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return EstimateResponse{}, fmt.Errorf("Failed to retrieve estimate (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve estimate (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return EstimateResponse{}, fmt.Errorf("Data retrieved was not 'EstimateResponse'")
+}
+
+// ListEstimates will return the list of estimates matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/estimates/#list-estimates
+func (c *API) ListEstimates(params map[string]zoho.Parameter) (data ListEstimatesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         EstimatesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, EstimatesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListEstimatesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"estimate_number": "",
+			"customer_id":     "",
+			"status":          "", // draft, sent, invoiced, accepted, declined, expired
+			"filter_by":       "",
+			"search_text":     "",
+			"sort_column":     "",
+			"page":            "1",
+			"per_page":        "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListEstimatesResponse{}, fmt.Errorf("Failed to retrieve estimates: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListEstimatesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve estimates: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListEstimatesResponse{}, fmt.Errorf("Data retrieved was not 'ListEstimatesResponse'")
+}
+
+// UpdateEstimate will modify the estimate specified by id
+// https://www.zoho.com/books/api/v3/estimates/#update-an-estimate
+func (c *API) UpdateEstimate(
+	id string,
+	request EstimateRequest,
+) (data EstimateResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: EstimatesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			EstimatesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &EstimateResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return EstimateResponse{}, fmt.Errorf("Failed to update estimate (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update estimate (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return EstimateResponse{}, fmt.Errorf("Data retrieved was not 'EstimateResponse'")
+}
+
+// DeleteEstimate will delete the estimate specified by id
+// https://www.zoho.com/books/api/v3/estimates/#delete-an-estimate
+func (c *API) DeleteEstimate(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: EstimatesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			EstimatesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete estimate (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete estimate (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// MarkEstimateAs will move the estimate specified by id to the given status
+// https://www.zoho.com/books/api/v3/estimates/#mark-an-estimate-as-sent
+// https://www.zoho.com/books/api/v3/estimates/#mark-an-estimate-as-accepted
+// https://www.zoho.com/books/api/v3/estimates/#mark-an-estimate-as-declined
+func (c *API) MarkEstimateAs(
+	id string,
+	status EstimateStatus,
+) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: EstimatesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/%s",
+			c.ZohoTLD,
+			EstimatesModule,
+			id,
+			status,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to mark estimate (%s) as %s: %s",
+			id,
+			status,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to mark estimate (%s) as %s: %s",
+				id,
+				status,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// EmailEstimate will send the estimate specified by id to the provided recipients
+// https://www.zoho.com/books/api/v3/estimates/#email-an-estimate
+func (c *API) EmailEstimate(id string, request EmailRequest) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: EstimatesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/email",
+			c.ZohoTLD,
+			EstimatesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to email estimate (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to email estimate (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ConvertEstimateToInvoice will create an invoice for the customer of the estimate specified by
+// id. The lines of the estimate are copied to the invoice which is linked back to the estimate,
+// fields set on request (invoice number, dates, etc.) are used for the rest of the invoice. The
+// discount, shipping charge and adjustment of the estimate are kept unless they are set on
+// request, an explicit zero removes them.
+// https://www.zoho.com/books/api/v3/invoices/#create-an-invoice
+func (c *API) ConvertEstimateToInvoice(
+	id string,
+	request InvoiceRequest,
+) (data InvoiceResponse, err error) {
+	estimate, err := c.GetEstimate(id)
+	if err != nil {
+		return InvoiceResponse{}, fmt.Errorf(
+			"Failed to convert estimate (%s) to invoice: %s",
+			id,
+			err,
+		)
+	}
+	e := estimate.Estimate
+
+	request.CustomerID = e.CustomerID
+	request.InvoicedEstimateID = id
+	if request.ExchangeRate == 0 {
+		request.ExchangeRate = e.ExchangeRate
+	}
+	if request.ContactPersons == nil {
+		request.ContactPersons = e.ContactPersons
+	}
+	if request.ReferenceNumber == "" {
+		request.ReferenceNumber = e.ReferenceNumber
+	}
+	if request.Notes == "" {
+		request.Notes = e.Notes
+	}
+	if request.Terms == "" {
+		request.Terms = e.Terms
+	}
+	if request.SalespersonName == "" {
+		request.SalespersonName = e.SalespersonName
+	}
+	if request.ProjectID == "" {
+		request.ProjectID = e.ProjectID
+	}
+	if request.CustomFields == nil {
+		request.CustomFields = finance.CustomFieldRequests(e.CustomFields)
+	}
+	if request.Discount == nil {
+		request.Discount = &e.Discount
+		request.IsDiscountBeforeTax = e.IsDiscountBeforeTax
+		request.DiscountType = e.DiscountType
+	}
+	if request.ShippingCharge == nil {
+		request.ShippingCharge = &e.ShippingCharge
+	}
+	if request.Adjustment == nil {
+		request.Adjustment = &e.Adjustment
+		request.AdjustmentDescription = e.AdjustmentDescription
+	}
+	request.IsInclusiveTax = e.IsInclusiveTax
+	request.LineItems = estimateLineItems(e)
+
+	data, err = c.CreateInvoice(request)
+	if err != nil {
+		return data, fmt.Errorf("Failed to convert estimate (%s) to invoice: %s", id, err)
+	}
+	return data, nil
+}
+
+// ConvertEstimateToSalesOrder will create a sales order for the customer of the estimate specified
+// by id. The lines of the estimate are copied to the sales order which is linked back to the
+// estimate, fields set on request (sales order number, dates, etc.) are used for the rest of the
+// sales order. The discount, shipping charge and adjustment of the estimate are kept unless they
+// are set on request, an explicit zero removes them.
+// https://www.zoho.com/books/api/v3/sales-order/#create-a-sales-order
+func (c *API) ConvertEstimateToSalesOrder(
+	id string,
+	request SalesOrderRequest,
+) (data SalesOrderResponse, err error) {
+	estimate, err := c.GetEstimate(id)
+	if err != nil {
+		return SalesOrderResponse{}, fmt.Errorf(
+			"Failed to convert estimate (%s) to sales order: %s",
+			id,
+			err,
+		)
+	}
+	e := estimate.Estimate
+
+	request.CustomerID = e.CustomerID
+	request.EstimateID = id
+	if request.CurrencyID == "" {
+		request.CurrencyID = e.CurrencyID
+	}
+	if request.ExchangeRate == 0 {
+		request.ExchangeRate = e.ExchangeRate
+	}
+	if request.ContactPersons == nil {
+		request.ContactPersons = e.ContactPersons
+	}
+	if request.ReferenceNumber == "" {
+		request.ReferenceNumber = e.ReferenceNumber
+	}
+	if request.Notes == "" {
+		request.Notes = e.Notes
+	}
+	if request.Terms == "" {
+		request.Terms = e.Terms
+	}
+	if request.SalespersonID == "" && request.SalespersonName == "" {
+		request.SalespersonID = e.SalespersonID
+		request.SalespersonName = e.SalespersonName
+	}
+	if request.CustomFields == nil {
+		request.CustomFields = finance.CustomFieldRequests(e.CustomFields)
+	}
+	if request.Discount == nil {
+		request.Discount = &e.Discount
+		request.IsDiscountBeforeTax = e.IsDiscountBeforeTax
+		request.DiscountType = e.DiscountType
+	}
+	if request.ShippingCharge == nil {
+		request.ShippingCharge = &e.ShippingCharge
+	}
+	if request.Adjustment == nil {
+		request.Adjustment = &e.Adjustment
+		request.AdjustmentDescription = e.AdjustmentDescription
+	}
+	request.IsInclusiveTax = e.IsInclusiveTax
+	request.LineItems = estimateLineItems(e)
+
+	data, err = c.CreateSalesOrder(request)
+	if err != nil {
+		return data, fmt.Errorf("Failed to convert estimate (%s) to sales order: %s", id, err)
+	}
+	return data, nil
+}
+
+// estimateLineItems returns the lines of the estimate as new lines of another document
+func estimateLineItems(e Estimate) []LineItem {
+	items := make([]LineItem, 0, len(e.LineItems))
+	for _, item := range e.LineItems {
+		items = append(items, item.Request())
+	}
+	return items
+}
+
+// EstimateRequest is the data provided to CreateEstimate and UpdateEstimate
+type EstimateRequest struct {
+	CustomerID            string               `json:"customer_id"`
+	CurrencyID            string               `json:"currency_id,omitempty"`
+	ContactPersons        []string             `json:"contact_persons,omitempty"`
+	TemplateID            string               `json:"template_id,omitempty"`
+	PlaceOfSupply         string               `json:"place_of_supply,omitempty"`
+	GstTreatment          string               `json:"gst_treatment,omitempty"`
+	GstNo                 string               `json:"gst_no,omitempty"`
+	EstimateNumber        string               `json:"estimate_number,omitempty"`
+	ReferenceNumber       string               `json:"reference_number,omitempty"`
+	Date                  *zoho.Date           `json:"date,omitempty"`
+	ExpiryDate            *zoho.Date           `json:"expiry_date,omitempty"`
+	ExchangeRate          float64              `json:"exchange_rate,omitempty"`
+	Discount              float64              `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool                 `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string               `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool                 `json:"is_inclusive_tax,omitempty"`
+	CustomBody            string               `json:"custom_body,omitempty"`
+	CustomSubject         string               `json:"custom_subject,omitempty"`
+	SalespersonName       string               `json:"salesperson_name,omitempty"`
+	CustomFields          []CustomFieldRequest `json:"custom_fields,omitempty"`
+	LineItems             []LineItem           `json:"line_items"`
+	Notes                 string               `json:"notes,omitempty"`
+	Terms                 string               `json:"terms,omitempty"`
+	ShippingCharge        *zoho.Money          `json:"shipping_charge,omitempty"`
+	Adjustment            *zoho.Money          `json:"adjustment,omitempty"`
+	AdjustmentDescription string               `json:"adjustment_description,omitempty"`
+	TaxID                 string               `json:"tax_id,omitempty"`
+	TaxExemptionID        string               `json:"tax_exemption_id,omitempty"`
+	TaxAuthorityID        string               `json:"tax_authority_id,omitempty"`
+	ProjectID             string               `json:"project_id,omitempty"`
+}
+
+// Estimate is an estimate as returned by the Books API
+type Estimate struct {
+	EstimateID            string        `json:"estimate_id"`
+	EstimateNumber        string        `json:"estimate_number"`
+	Date                  zoho.Date     `json:"date"`
+	ReferenceNumber       string        `json:"reference_number"`
+	IsPreGst              bool          `json:"is_pre_gst"`
+	PlaceOfSupply         string        `json:"place_of_supply"`
+	GstNo                 string        `json:"gst_no"`
+	GstTreatment          string        `json:"gst_treatment"`
+	Status                string        `json:"status"`
+	CustomerID            string        `json:"customer_id"`
+	CustomerName          string        `json:"customer_name"`
+	ContactPersons        []string      `json:"contact_persons"`
+	CurrencyID            string        `json:"currency_id"`
+	CurrencyCode          string        `json:"currency_code"`
+	ExchangeRate          float64       `json:"exchange_rate"`
+	ExpiryDate            zoho.Date     `json:"expiry_date"`
+	Discount              float64       `json:"discount"`
+	IsDiscountBeforeTax   bool          `json:"is_discount_before_tax"`
+	DiscountType          string        `json:"discount_type"`
+	IsInclusiveTax        bool          `json:"is_inclusive_tax"`
+	LineItems             []LineItem    `json:"line_items"`
+	ShippingCharge        zoho.Money    `json:"shipping_charge"`
+	Adjustment            zoho.Money    `json:"adjustment"`
+	AdjustmentDescription string        `json:"adjustment_description"`
+	SubTotal              zoho.Money    `json:"sub_total"`
+	Total                 zoho.Money    `json:"total"`
+	TaxTotal              zoho.Money    `json:"tax_total"`
+	PricePrecision        int64         `json:"price_precision"`
+	Taxes                 []TaxSummary  `json:"taxes"`
+	BillingAddress        Address       `json:"billing_address"`
+	ShippingAddress       Address       `json:"shipping_address"`
+	Notes                 string        `json:"notes"`
+	Terms                 string        `json:"terms"`
+	CustomFields          []CustomField `json:"custom_fields"`
+	TemplateID            string        `json:"template_id"`
+	TemplateName          string        `json:"template_name"`
+	SalespersonID         string        `json:"salesperson_id"`
+	SalespersonName       string        `json:"salesperson_name"`
+	ProjectID             string        `json:"project_id"`
+	InvoiceIDs            []string      `json:"invoice_ids"`
+	SalesorderID          string        `json:"salesorder_id"`
+	IsViewedByClient      bool          `json:"is_viewed_by_client"`
+	ClientViewedTime      zoho.Time     `json:"client_viewed_time"`
+	AcceptedDate          zoho.Date     `json:"accepted_date"`
+	DeclinedDate          zoho.Date     `json:"declined_date"`
+	CreatedTime           zoho.Time     `json:"created_time"`
+	LastModifiedTime      zoho.Time     `json:"last_modified_time"`
+}
+
+// EstimateResponse is the data returned by CreateEstimate, GetEstimate and UpdateEstimate
+type EstimateResponse struct {
+	Code     int64    `json:"code"`
+	Message  string   `json:"message"`
+	Estimate Estimate `json:"estimate"`
+}
+
+// ListEstimatesResponse is the data returned by ListEstimates
+type ListEstimatesResponse struct {
+	Code      int64  `json:"code"`
+	Message   string `json:"message"`
+	Estimates []struct {
+		EstimateID       string     `json:"estimate_id"`
+		CustomerName     string     `json:"customer_name"`
+		CustomerID       string     `json:"customer_id"`
+		Status           string     `json:"status"`
+		EstimateNumber   string     `json:"estimate_number"`
+		ReferenceNumber  string     `json:"reference_number"`
+		Date             zoho.Date  `json:"date"`
+		ExpiryDate       zoho.Date  `json:"expiry_date"`
+		CurrencyID       string     `json:"currency_id"`
+		CurrencyCode     string     `json:"currency_code"`
+		Total            zoho.Money `json:"total"`
+		AcceptedDate     zoho.Date  `json:"accepted_date"`
+		DeclinedDate     zoho.Date  `json:"declined_date"`
+		IsEmailed        bool       `json:"is_emailed"`
+		CreatedTime      zoho.Time  `json:"created_time"`
+		LastModifiedTime zoho.Time  `json:"last_modified_time"`
+		SalespersonID    string     `json:"salesperson_id"`
+		SalespersonName  string     `json:"salesperson_name"`
+	} `json:"estimates"`
+	PageContext PageContext `json:"page_context"`
+}
@@ -0,0 +1,114 @@
+package books
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+const testEstimate = `{"code":0,"estimate":{
+	"estimate_id":"460000000059010",
+	"customer_id":"460000000017138",
+	"exchange_rate":1.5,
+	"reference_number":"QRT-12346",
+	"discount":10,
+	"is_discount_before_tax":true,
+	"discount_type":"entity_level",
+	"line_items":[{"line_item_id":"460000000059014","item_id":"460000000017088",
+		"rate":120.00,"quantity":2,"tax_id":"460000000027005","tax_name":"VAT",
+		"tax_percentage":12.5,"item_total":240.00}],
+	"shipping_charge":7.5,
+	"adjustment":0
+}}`
+
+func TestConvertEstimateToInvoice(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var created map[string]interface{}
+	s.Handle(http.MethodGet, "/api/v3/estimates/460000000059010", zohotest.Reply(testEstimate))
+	s.Handle(http.MethodPost, "/api/v3/invoices", func(w http.ResponseWriter, r *http.Request) {
+		zohotest.DecodeJSON(t, r, &created)
+		w.Write([]byte(`{"code":0,"invoice":{"invoice_id":"460000000059100"}}`))
+	})
+
+	// An explicit zero removes the discount of the estimate
+	discount := 0.0
+	data, err := New(s.Client()).ConvertEstimateToInvoice(
+		"460000000059010",
+		InvoiceRequest{InvoiceNumber: "INV-00003", Discount: &discount},
+	)
+	if err != nil {
+		t.Fatalf("ConvertEstimateToInvoice returned error: %s", err)
+	}
+	if data.Invoice.InvoiceID != "460000000059100" {
+		t.Errorf("InvoiceID = %s, want 460000000059100", data.Invoice.InvoiceID)
+	}
+
+	for field, want := range map[string]interface{}{
+		"customer_id":          "460000000017138",
+		"invoiced_estimate_id": "460000000059010",
+		"invoice_number":       "INV-00003",
+		"reference_number":     "QRT-12346",
+		"exchange_rate":        1.5,
+		"discount":             0.0,
+		"shipping_charge":      7.5,
+	} {
+		if created[field] != want {
+			t.Errorf("%s = %v, want %v", field, created[field], want)
+		}
+	}
+	items, _ := created["line_items"].([]interface{})
+	if len(items) != 1 {
+		t.Fatalf("line_items = %v, want 1 line", created["line_items"])
+	}
+	item := items[0].(map[string]interface{})
+	if item["item_id"] != "460000000017088" || item["rate"] != 120.0 ||
+		item["quantity"] != 2.0 || item["tax_id"] != "460000000027005" {
+		t.Errorf("line item = %v", item)
+	}
+	for _, field := range []string{"line_item_id", "tax_name", "tax_percentage", "item_total"} {
+		if _, ok := item[field]; ok {
+			t.Errorf("line item has read only field %s: %v", field, item)
+		}
+	}
+}
+
+func TestConvertEstimateToSalesOrder(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var created map[string]interface{}
+	s.Handle(http.MethodGet, "/api/v3/estimates/460000000059010", zohotest.Reply(testEstimate))
+	s.Handle(http.MethodPost, "/api/v3/salesorders", func(w http.ResponseWriter, r *http.Request) {
+		zohotest.DecodeJSON(t, r, &created)
+		w.Write([]byte(`{"code":0,"salesorder":{"salesorder_id":"460000000059200"}}`))
+	})
+
+	_, err := New(s.Client()).ConvertEstimateToSalesOrder("460000000059010", SalesOrderRequest{})
+	if err != nil {
+		t.Fatalf("ConvertEstimateToSalesOrder returned error: %s", err)
+	}
+
+	// Without overrides the discount of the estimate is kept
+	for field, want := range map[string]interface{}{
+		"customer_id":            "460000000017138",
+		"estimate_id":            "460000000059010",
+		"discount":               10.0,
+		"is_discount_before_tax": true,
+		"discount_type":          "entity_level",
+		"shipping_charge":        7.5,
+	} {
+		if created[field] != want {
+			t.Errorf("%s = %v, want %v", field, created[field], want)
+		}
+	}
+	items, _ := created["line_items"].([]interface{})
+	if len(items) != 1 {
+		t.Fatalf("line_items = %v, want 1 line", created["line_items"])
+	}
+	if _, ok := items[0].(map[string]interface{})["item_total"]; ok {
+		t.Errorf("line item has read only field item_total: %v", items[0])
+	}
+}
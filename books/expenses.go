@@ -1 +1,425 @@
 package books
+
+import (
+	"fmt"
+	"io"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateExpense will record a new expense.
+// Itemized expenses are recorded by providing LineItems, mileage expenses by providing the Mileage fields.
+// https://www.zoho.com/books/api/v3/expenses/#create-an-expense
+func (c *API) CreateExpense(request ExpenseRequest) (data ExpenseResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         ExpensesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, ExpensesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ExpenseResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ExpenseResponse{}, fmt.Errorf("Failed to create expense: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExpenseResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create expense: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ExpenseResponse{}, fmt.Errorf("Data retrieved was not 'ExpenseResponse'")
+}
+
+// GetExpense will return the expense specified by id
+// https://www.zoho.com/books/api/v3/expenses/#get-an-expense
+func (c *API) GetExpense(id string) (data ExpenseResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ExpenseResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ExpenseResponse{}, fmt.Errorf("Failed to retrieve expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExpenseResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ExpenseResponse{}, fmt.Errorf("Data retrieved was not 'ExpenseResponse'")
+}
+
+// ListExpenses will return the list of expenses matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/expenses/#list-expenses
+func (c *API) ListExpenses(
+	params map[string]zoho.Parameter,
+) (data ListExpensesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         ExpensesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, ExpensesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListExpensesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"description":             "",
+			"reference_number":        "",
+			"date":                    "",
+			"status":                  "", // unbilled, invoiced, reimbursed, non-billable, billable
+			"account_name":            "",
+			"customer_name":           "",
+			"vendor_name":             "",
+			"customer_id":             "",
+			"vendor_id":               "",
+			"recurring_expense_id":    "",
+			"paid_through_account_id": "",
+			"filter_by":               "", // Status.All, Status.Billable, Status.Nonbillable, Status.Reimbursed, Status.Invoiced, Status.Unbilled
+			"search_text":             "",
+			"sort_column":             "",
+			"page":                    "1",
+			"per_page":                "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListExpensesResponse{}, fmt.Errorf("Failed to retrieve expenses: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListExpensesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve expenses: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListExpensesResponse{}, fmt.Errorf("Data retrieved was not 'ListExpensesResponse'")
+}
+
+// UpdateExpense will modify the expense specified by id
+// https://www.zoho.com/books/api/v3/expenses/#update-an-expense
+func (c *API) UpdateExpense(id string, request ExpenseRequest) (data ExpenseResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &ExpenseResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ExpenseResponse{}, fmt.Errorf("Failed to update expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ExpenseResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ExpenseResponse{}, fmt.Errorf("Data retrieved was not 'ExpenseResponse'")
+}
+
+// DeleteExpense will delete the expense specified by id
+// https://www.zoho.com/books/api/v3/expenses/#delete-an-expense
+func (c *API) DeleteExpense(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// AddExpenseReceipt will attach a receipt to the expense specified by id.
+// The contents of receipt are streamed to Zoho, fileName is the name the receipt is stored under.
+// https://www.zoho.com/books/api/v3/expenses/#add-receipt-to-an-expense
+func (c *API) AddExpenseReceipt(
+	id string,
+	fileName string,
+	receipt io.Reader,
+) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/receipt",
+			c.ZohoTLD,
+			ExpensesModule,
+			id,
+		),
+		Method:           zoho.HTTPPost,
+		ResponseData:     &ActionResponse{},
+		BodyFormat:       zoho.FILE,
+		AttachmentReader: receipt,
+		AttachmentName:   fileName,
+		AttachmentField:  "receipt",
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to add receipt to expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to add receipt to expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// DeleteExpenseReceipt will remove the receipt attached to the expense specified by id
+// https://www.zoho.com/books/api/v3/expenses/#delete-a-receipt
+func (c *API) DeleteExpenseReceipt(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/receipt",
+			c.ZohoTLD,
+			ExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete receipt of expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete receipt of expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// GetExpenseReceipt will write the receipt attached to the expense specified by id to w.
+// The receipt is streamed from Zoho without being held in memory.
+// https://www.zoho.com/books/api/v3/expenses/#get-an-expense-receipt
+func (c *API) GetExpenseReceipt(id string, w io.Writer) (err error) {
+	endpoint := zoho.Endpoint{
+		Name: ExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/receipt",
+			c.ZohoTLD,
+			ExpensesModule,
+			id,
+		),
+		Method:         zoho.HTTPGet,
+		ResponseData:   &ActionResponse{},
+		ResponseWriter: w,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return fmt.Errorf("Failed to retrieve receipt of expense (%s): %s", id, err)
+	}
+
+	// An error body is decoded when Zoho did not return the receipt
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok && v.Code != 0 {
+		return fmt.Errorf("Failed to retrieve receipt of expense (%s): %s", id, v.Message)
+	}
+
+	return nil
+}
+
+// ExpenseRequest is the data provided to CreateExpense and UpdateExpense
+type ExpenseRequest struct {
+	AccountID            string               `json:"account_id,omitempty"`
+	Date                 *zoho.Date           `json:"date,omitempty"`
+	Amount               *zoho.Money          `json:"amount,omitempty"`
+	PaidThroughAccountID string               `json:"paid_through_account_id,omitempty"`
+	TaxID                string               `json:"tax_id,omitempty"`
+	IsInclusiveTax       bool                 `json:"is_inclusive_tax,omitempty"`
+	IsBillable           bool                 `json:"is_billable,omitempty"`
+	ReferenceNumber      string               `json:"reference_number,omitempty"`
+	Description          string               `json:"description,omitempty"`
+	CustomerID           string               `json:"customer_id,omitempty"`
+	VendorID             string               `json:"vendor_id,omitempty"`
+	ProjectID            string               `json:"project_id,omitempty"`
+	CurrencyID           string               `json:"currency_id,omitempty"`
+	ExchangeRate         float64              `json:"exchange_rate,omitempty"`
+	GstNo                string               `json:"gst_no,omitempty"`
+	SourceOfSupply       string               `json:"source_of_supply,omitempty"`
+	DestinationOfSupply  string               `json:"destination_of_supply,omitempty"`
+	ReverseChargeTaxID   string               `json:"reverse_charge_tax_id,omitempty"`
+	CustomFields         []CustomFieldRequest `json:"custom_fields,omitempty"`
+
+	// LineItems itemizes the expense across several accounts, Amount and AccountID are ignored when provided
+	LineItems []ExpenseLineItem `json:"line_items,omitempty"`
+
+	// Mileage fields, used when recording the distance travelled rather than an amount
+	IsMileage    bool        `json:"is_mileage,omitempty"`
+	MileageType  string      `json:"mileage_type,omitempty"` // manual, odometer
+	MileageRate  *zoho.Money `json:"mileage_rate,omitempty"`
+	MileageUnit  string      `json:"mileage_unit,omitempty"` // km, mile
+	Distance     float64     `json:"distance,omitempty"`
+	StartReading float64     `json:"start_reading,omitempty"`
+	EndReading   float64     `json:"end_reading,omitempty"`
+	EmployeeID   string      `json:"employee_id,omitempty"`
+	VehicleType  string      `json:"vehicle_type,omitempty"`
+}
+
+// ExpenseLineItem is a single line of an itemized expense
+type ExpenseLineItem struct {
+	LineItemID     string     `json:"line_item_id,omitempty"`
+	AccountID      string     `json:"account_id"`
+	AccountName    string     `json:"account_name,omitempty"`
+	Description    string     `json:"description,omitempty"`
+	Amount         zoho.Money `json:"amount"`
+	TaxID          string     `json:"tax_id,omitempty"`
+	TaxName        string     `json:"tax_name,omitempty"`
+	TaxPercentage  float64    `json:"tax_percentage,omitempty"`
+	TaxExemptionID string     `json:"tax_exemption_id,omitempty"`
+	ItemOrder      int64      `json:"item_order,omitempty"`
+	ProductType    string     `json:"product_type,omitempty"`
+	HsnOrSac       string     `json:"hsn_or_sac,omitempty"`
+}
+
+// Expense is an expense as returned by the Books API
+type Expense struct {
+	ExpenseID              string            `json:"expense_id"`
+	TransactionID          string            `json:"transaction_id"`
+	TransactionType        string            `json:"transaction_type"`
+	Date                   zoho.Date         `json:"date"`
+	Status                 string            `json:"status"`
+	AccountID              string            `json:"account_id"`
+	AccountName            string            `json:"account_name"`
+	PaidThroughAccountID   string            `json:"paid_through_account_id"`
+	PaidThroughAccountName string            `json:"paid_through_account_name"`
+	VendorID               string            `json:"vendor_id"`
+	VendorName             string            `json:"vendor_name"`
+	CustomerID             string            `json:"customer_id"`
+	CustomerName           string            `json:"customer_name"`
+	ProjectID              string            `json:"project_id"`
+	ProjectName            string            `json:"project_name"`
+	CurrencyID             string            `json:"currency_id"`
+	CurrencyCode           string            `json:"currency_code"`
+	ExchangeRate           float64           `json:"exchange_rate"`
+	TaxID                  string            `json:"tax_id"`
+	TaxName                string            `json:"tax_name"`
+	TaxPercentage          float64           `json:"tax_percentage"`
+	TaxAmount              zoho.Money        `json:"tax_amount"`
+	SubTotal               zoho.Money        `json:"sub_total"`
+	Total                  zoho.Money        `json:"total"`
+	BcyTotal               zoho.Money        `json:"bcy_total"`
+	Amount                 zoho.Money        `json:"amount"`
+	IsInclusiveTax         bool              `json:"is_inclusive_tax"`
+	IsBillable             bool              `json:"is_billable"`
+	IsPersonal             bool              `json:"is_personal"`
+	ReferenceNumber        string            `json:"reference_number"`
+	Description            string            `json:"description"`
+	InvoiceID              string            `json:"invoice_id"`
+	InvoiceNumber          string            `json:"invoice_number"`
+	RecurringExpenseID     string            `json:"recurring_expense_id"`
+	ReceiptName            string            `json:"expense_receipt_name"`
+	LineItems              []ExpenseLineItem `json:"line_items"`
+	IsMileage              bool              `json:"is_mileage"`
+	MileageType            string            `json:"mileage_type"`
+	MileageRate            zoho.Money        `json:"mileage_rate"`
+	MileageUnit            string            `json:"mileage_unit"`
+	Distance               float64           `json:"distance"`
+	StartReading           float64           `json:"start_reading"`
+	EndReading             float64           `json:"end_reading"`
+	EmployeeID             string            `json:"employee_id"`
+	EmployeeName           string            `json:"employee_name"`
+	CustomFields           []CustomField     `json:"custom_fields"`
+	CreatedTime            zoho.Time         `json:"created_time"`
+	LastModifiedTime       zoho.Time         `json:"last_modified_time"`
+}
+
+// ExpenseResponse is the data returned by CreateExpense, GetExpense and UpdateExpense
+type ExpenseResponse struct {
+	Code    int64   `json:"code"`
+	Message string  `json:"message"`
+	Expense Expense `json:"expense"`
+}
+
+// ListExpensesResponse is the data returned by ListExpenses
+type ListExpensesResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Expenses    []Expense   `json:"expenses"`
+	PageContext PageContext `json:"page_context"`
+}
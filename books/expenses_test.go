@@ -0,0 +1,30 @@
+package books
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestUpdateExpensePartial(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var sent map[string]interface{}
+	path := "/api/v3/expenses/460000000070001"
+	s.Handle(http.MethodPut, path, func(w http.ResponseWriter, r *http.Request) {
+		zohotest.DecodeJSON(t, r, &sent)
+		w.Write([]byte(`{"code":0,"expense":{"expense_id":"460000000070001"}}`))
+	})
+
+	// Fields which are not provided are left unchanged
+	api := New(s.Client())
+	_, err := api.UpdateExpense("460000000070001", ExpenseRequest{Description: "Taxi fare"})
+	if err != nil {
+		t.Fatalf("UpdateExpense returned error: %s", err)
+	}
+	if len(sent) != 1 || sent["description"] != "Taxi fare" {
+		t.Errorf("body = %v, want only the description", sent)
+	}
+}
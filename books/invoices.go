@@ -1 +1,168 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateInvoice will create a new invoice for a customer
+// https://www.zoho.com/books/api/v3/invoices/#create-an-invoice
+func (c *API) CreateInvoice(request InvoiceRequest) (data InvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         InvoicesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, InvoicesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &InvoiceResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return InvoiceResponse{}, fmt.Errorf("Failed to create invoice: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create invoice: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return InvoiceResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceResponse'")
+}
+
+// GetInvoice will return the invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#get-an-invoice
+func (c *API) GetInvoice(id string) (data InvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: InvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			InvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &InvoiceResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return InvoiceResponse{}, fmt.Errorf("Failed to retrieve invoice (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return InvoiceResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceResponse'")
+}
+
+// InvoiceRequest is the data provided to CreateInvoice
+type InvoiceRequest struct {
+	CustomerID            string               `json:"customer_id"`
+	ContactPersons        []string             `json:"contact_persons,omitempty"`
+	InvoiceNumber         string               `json:"invoice_number,omitempty"`
+	ReferenceNumber       string               `json:"reference_number,omitempty"`
+	PlaceOfSupply         string               `json:"place_of_supply,omitempty"`
+	GstTreatment          string               `json:"gst_treatment,omitempty"`
+	GstNo                 string               `json:"gst_no,omitempty"`
+	TemplateID            string               `json:"template_id,omitempty"`
+	Date                  *zoho.Date           `json:"date,omitempty"`
+	PaymentTerms          int64                `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string               `json:"payment_terms_label,omitempty"`
+	DueDate               *zoho.Date           `json:"due_date,omitempty"`
+	Discount              *float64             `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool                 `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string               `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool                 `json:"is_inclusive_tax,omitempty"`
+	ExchangeRate          float64              `json:"exchange_rate,omitempty"`
+	RecurringInvoiceID    string               `json:"recurring_invoice_id,omitempty"`
+	InvoicedEstimateID    string               `json:"invoiced_estimate_id,omitempty"`
+	SalespersonName       string               `json:"salesperson_name,omitempty"`
+	CustomFields          []CustomFieldRequest `json:"custom_fields,omitempty"`
+	ProjectID             string               `json:"project_id,omitempty"`
+	LineItems             []LineItem           `json:"line_items"`
+	PaymentOptions        *PaymentOptions      `json:"payment_options,omitempty"`
+	AllowPartialPayments  bool                 `json:"allow_partial_payments,omitempty"`
+	CustomBody            string               `json:"custom_body,omitempty"`
+	CustomSubject         string               `json:"custom_subject,omitempty"`
+	Notes                 string               `json:"notes,omitempty"`
+	Terms                 string               `json:"terms,omitempty"`
+	ShippingCharge        *zoho.Money          `json:"shipping_charge,omitempty"`
+	Adjustment            *zoho.Money          `json:"adjustment,omitempty"`
+	AdjustmentDescription string               `json:"adjustment_description,omitempty"`
+	Reason                string               `json:"reason,omitempty"`
+	TaxAuthorityID        string               `json:"tax_authority_id,omitempty"`
+	TaxExemptionID        string               `json:"tax_exemption_id,omitempty"`
+}
+
+// Invoice is an invoice as returned by the Books API
+type Invoice struct {
+	InvoiceID             string         `json:"invoice_id"`
+	InvoiceNumber         string         `json:"invoice_number"`
+	Date                  zoho.Date      `json:"date"`
+	DueDate               zoho.Date      `json:"due_date"`
+	Status                string         `json:"status"`
+	ReferenceNumber       string         `json:"reference_number"`
+	PaymentTerms          int64          `json:"payment_terms"`
+	PaymentTermsLabel     string         `json:"payment_terms_label"`
+	CustomerID            string         `json:"customer_id"`
+	CustomerName          string         `json:"customer_name"`
+	ContactPersons        []string       `json:"contact_persons"`
+	CurrencyID            string         `json:"currency_id"`
+	CurrencyCode          string         `json:"currency_code"`
+	ExchangeRate          float64        `json:"exchange_rate"`
+	Discount              float64        `json:"discount"`
+	IsDiscountBeforeTax   bool           `json:"is_discount_before_tax"`
+	DiscountType          string         `json:"discount_type"`
+	IsInclusiveTax        bool           `json:"is_inclusive_tax"`
+	RecurringInvoiceID    string         `json:"recurring_invoice_id"`
+	ProjectID             string         `json:"project_id"`
+	LineItems             []LineItem     `json:"line_items"`
+	ShippingCharge        zoho.Money     `json:"shipping_charge"`
+	Adjustment            zoho.Money     `json:"adjustment"`
+	AdjustmentDescription string         `json:"adjustment_description"`
+	SubTotal              zoho.Money     `json:"sub_total"`
+	TaxTotal              zoho.Money     `json:"tax_total"`
+	Total                 zoho.Money     `json:"total"`
+	Taxes                 []TaxSummary   `json:"taxes"`
+	PaymentMade           zoho.Money     `json:"payment_made"`
+	CreditsApplied        zoho.Money     `json:"credits_applied"`
+	WriteOffAmount        zoho.Money     `json:"write_off_amount"`
+	Balance               zoho.Money     `json:"balance"`
+	PricePrecision        int64          `json:"price_precision"`
+	AllowPartialPayments  bool           `json:"allow_partial_payments"`
+	PaymentOptions        PaymentOptions `json:"payment_options"`
+	BillingAddress        Address        `json:"billing_address"`
+	ShippingAddress       Address        `json:"shipping_address"`
+	Notes                 string         `json:"notes"`
+	Terms                 string         `json:"terms"`
+	CustomFields          []CustomField  `json:"custom_fields"`
+	TemplateID            string         `json:"template_id"`
+	TemplateName          string         `json:"template_name"`
+	SalespersonID         string         `json:"salesperson_id"`
+	SalespersonName       string         `json:"salesperson_name"`
+	InvoiceURL            string         `json:"invoice_url"`
+	CreatedTime           zoho.Time      `json:"created_time"`
+	LastModifiedTime      zoho.Time      `json:"last_modified_time"`
+}
+
+// InvoiceResponse is the data returned by CreateInvoice and GetInvoice
+type InvoiceResponse struct {
+	Code    int64   `json:"code"`
+	Message string  `json:"message"`
+	Invoice Invoice `json:"invoice"`
+}
@@ -1 +1,335 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateItem will create a new item
+// https://www.zoho.com/books/api/v3/items/#create-an-item
+func (c *API) CreateItem(request ItemRequest) (data ItemResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         ItemsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, ItemsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ItemResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ItemResponse{}, fmt.Errorf("Failed to create item: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ItemResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create item: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ItemResponse{}, fmt.Errorf("Data retrieved was not 'ItemResponse'")
+}
+
+// GetItem will return the item specified by id
+// https://www.zoho.com/books/api/v3/items/#get-an-item
+func (c *API) GetItem(id string) (data ItemResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ItemsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ItemsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ItemResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ItemResponse{}, fmt.Errorf("Failed to retrieve item (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ItemResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve item (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ItemResponse{}, fmt.Errorf("Data retrieved was not 'ItemResponse'")
+}
+
+// ListItems will return the list of items matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/items/#list-items
+func (c *API) ListItems(params map[string]zoho.Parameter) (data ListItemsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         ItemsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, ItemsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListItemsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"name":        "",
+			"description": "",
+			"rate":        "",
+			"tax_id":      "",
+			"filter_by":   "", // Status.All, Status.Active, Status.Inactive
+			"search_text": "",
+			"sort_column": "",
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListItemsResponse{}, fmt.Errorf("Failed to retrieve items: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListItemsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve items: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListItemsResponse{}, fmt.Errorf("Data retrieved was not 'ListItemsResponse'")
+}
+
+// UpdateItem will modify the item specified by id
+// https://www.zoho.com/books/api/v3/items/#update-an-item
+func (c *API) UpdateItem(id string, request ItemRequest) (data ItemResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ItemsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ItemsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &ItemResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ItemResponse{}, fmt.Errorf("Failed to update item (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ItemResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update item (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ItemResponse{}, fmt.Errorf("Data retrieved was not 'ItemResponse'")
+}
+
+// DeleteItem will delete the item specified by id
+// https://www.zoho.com/books/api/v3/items/#delete-an-item
+func (c *API) DeleteItem(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ItemsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ItemsModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete item (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete item (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// MarkItemAsActive will mark the item specified by id as active
+// https://www.zoho.com/books/api/v3/items/#mark-as-active
+func (c *API) MarkItemAsActive(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ItemsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/active",
+			c.ZohoTLD,
+			ItemsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to mark item (%s) as active: %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to mark item (%s) as active: %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// MarkItemAsInactive will mark the item specified by id as inactive
+// https://www.zoho.com/books/api/v3/items/#mark-as-inactive
+func (c *API) MarkItemAsInactive(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ItemsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/inactive",
+			c.ZohoTLD,
+			ItemsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to mark item (%s) as inactive: %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to mark item (%s) as inactive: %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ItemRequest is the data provided to CreateItem and UpdateItem
+type ItemRequest struct {
+	Name                string               `json:"name"`
+	Rate                zoho.Money           `json:"rate"`
+	Description         string               `json:"description,omitempty"`
+	SKU                 string               `json:"sku,omitempty"`
+	Unit                string               `json:"unit,omitempty"`
+	ProductType         string               `json:"product_type,omitempty"` // goods, service
+	ItemType            string               `json:"item_type,omitempty"`    // sales, purchases, sales_and_purchases, inventory
+	TaxID               string               `json:"tax_id,omitempty"`
+	TaxPercentage       float64              `json:"tax_percentage,omitempty"`
+	IsTaxable           bool                 `json:"is_taxable,omitempty"`
+	TaxExemptionID      string               `json:"tax_exemption_id,omitempty"`
+	AccountID           string               `json:"account_id,omitempty"`
+	PurchaseDescription string               `json:"purchase_description,omitempty"`
+	PurchaseRate        *zoho.Money          `json:"purchase_rate,omitempty"`
+	PurchaseAccountID   string               `json:"purchase_account_id,omitempty"`
+	InventoryAccountID  string               `json:"inventory_account_id,omitempty"`
+	VendorID            string               `json:"vendor_id,omitempty"`
+	ReorderLevel        float64              `json:"reorder_level,omitempty"`
+	InitialStock        float64              `json:"initial_stock,omitempty"`
+	InitialStockRate    *zoho.Money          `json:"initial_stock_rate,omitempty"`
+	HsnOrSac            string               `json:"hsn_or_sac,omitempty"`
+	ItemTaxPreferences  []ItemTaxPreference  `json:"item_tax_preferences,omitempty"`
+	CustomFields        []CustomFieldRequest `json:"custom_fields,omitempty"`
+}
+
+// ItemTaxPreference is the tax applied to an item for intra or inter state supplies
+type ItemTaxPreference struct {
+	TaxID            string `json:"tax_id"`
+	TaxSpecification string `json:"tax_specification"` // intra, inter
+}
+
+// Item is an item as returned by the Books API
+type Item struct {
+	ItemID              string              `json:"item_id"`
+	Name                string              `json:"name"`
+	Status              string              `json:"status"`
+	Description         string              `json:"description"`
+	Rate                zoho.Money          `json:"rate"`
+	Unit                string              `json:"unit"`
+	SKU                 string              `json:"sku"`
+	ProductType         string              `json:"product_type"`
+	ItemType            string              `json:"item_type"`
+	TaxID               string              `json:"tax_id"`
+	TaxName             string              `json:"tax_name"`
+	TaxPercentage       float64             `json:"tax_percentage"`
+	TaxType             string              `json:"tax_type"`
+	IsTaxable           bool                `json:"is_taxable"`
+	TaxExemptionID      string              `json:"tax_exemption_id"`
+	AccountID           string              `json:"account_id"`
+	AccountName         string              `json:"account_name"`
+	PurchaseDescription string              `json:"purchase_description"`
+	PurchaseRate        zoho.Money          `json:"purchase_rate"`
+	PurchaseAccountID   string              `json:"purchase_account_id"`
+	PurchaseAccountName string              `json:"purchase_account_name"`
+	InventoryAccountID  string              `json:"inventory_account_id"`
+	VendorID            string              `json:"vendor_id"`
+	VendorName          string              `json:"vendor_name"`
+	ReorderLevel        float64             `json:"reorder_level"`
+	StockOnHand         float64             `json:"stock_on_hand"`
+	HsnOrSac            string              `json:"hsn_or_sac"`
+	ItemTaxPreferences  []ItemTaxPreference `json:"item_tax_preferences"`
+	CustomFields        []CustomField       `json:"custom_fields"`
+	CreatedTime         zoho.Time           `json:"created_time"`
+	LastModifiedTime    zoho.Time           `json:"last_modified_time"`
+}
+
+// ItemResponse is the data returned by CreateItem, GetItem and UpdateItem
+type ItemResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+	Item    Item   `json:"item"`
+}
+
+// ListItemsResponse is the data returned by ListItems
+type ListItemsResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Items       []Item      `json:"items"`
+	PageContext PageContext `json:"page_context"`
+}
@@ -1 +1,160 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateOpeningBalance will record the opening balances of the accounts of the organization
+// https://www.zoho.com/books/api/v3/opening-balance/#create-opening-balance
+func (c *API) CreateOpeningBalance(
+	request OpeningBalanceRequest,
+) (data OpeningBalanceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         OpeningBalancesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, OpeningBalancesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &OpeningBalanceResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return OpeningBalanceResponse{}, fmt.Errorf("Failed to create opening balance: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*OpeningBalanceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create opening balance: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return OpeningBalanceResponse{}, fmt.Errorf("Data retrieved was not 'OpeningBalanceResponse'")
+}
+
+// GetOpeningBalance will return the opening balances of the accounts of the organization
+// https://www.zoho.com/books/api/v3/opening-balance/#get-opening-balance
+func (c *API) GetOpeningBalance() (data OpeningBalanceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         OpeningBalancesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, OpeningBalancesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &OpeningBalanceResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return OpeningBalanceResponse{}, fmt.Errorf("Failed to retrieve opening balance: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*OpeningBalanceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve opening balance: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return OpeningBalanceResponse{}, fmt.Errorf("Data retrieved was not 'OpeningBalanceResponse'")
+}
+
+// UpdateOpeningBalance will modify the opening balances of the accounts of the organization
+// https://www.zoho.com/books/api/v3/opening-balance/#update-opening-balance
+func (c *API) UpdateOpeningBalance(
+	request OpeningBalanceRequest,
+) (data OpeningBalanceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         OpeningBalancesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, OpeningBalancesModule),
+		Method:       zoho.HTTPPut,
+		ResponseData: &OpeningBalanceResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return OpeningBalanceResponse{}, fmt.Errorf("Failed to update opening balance: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*OpeningBalanceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update opening balance: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return OpeningBalanceResponse{}, fmt.Errorf("Data retrieved was not 'OpeningBalanceResponse'")
+}
+
+// DeleteOpeningBalance will delete the opening balances of the accounts of the organization
+// https://www.zoho.com/books/api/v3/opening-balance/#delete-opening-balance
+func (c *API) DeleteOpeningBalance() (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         OpeningBalancesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, OpeningBalancesModule),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete opening balance: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete opening balance: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// OpeningBalanceRequest is the data provided to CreateOpeningBalance and UpdateOpeningBalance
+type OpeningBalanceRequest struct {
+	Date     zoho.Date               `json:"date"` // yyyy-mm-dd
+	Accounts []OpeningBalanceAccount `json:"accounts"`
+}
+
+// OpeningBalanceAccount is the opening balance of a single account
+type OpeningBalanceAccount struct {
+	AccountID     string      `json:"account_id"`
+	AccountName   string      `json:"account_name,omitempty"`
+	DebitOrCredit string      `json:"debit_or_credit"` // debit, credit
+	Amount        zoho.Money  `json:"amount"`
+	ExchangeRate  float64     `json:"exchange_rate,omitempty"`
+	CurrencyID    string      `json:"currency_id,omitempty"`
+	CurrencyCode  string      `json:"currency_code,omitempty"`
+	BcyAmount     *zoho.Money `json:"bcy_amount,omitempty"`
+	LocationID    string      `json:"location_id,omitempty"`
+}
+
+// OpeningBalanceResponse is the data returned by CreateOpeningBalance, GetOpeningBalance and UpdateOpeningBalance
+type OpeningBalanceResponse struct {
+	Code           int64  `json:"code"`
+	Message        string `json:"message"`
+	OpeningBalance struct {
+		OpeningBalanceID string                  `json:"opening_balance_id"`
+		Date             zoho.Date               `json:"date"`
+		Accounts         []OpeningBalanceAccount `json:"accounts"`
+		Total            zoho.Money              `json:"total"`
+	} `json:"opening_balance"`
+}
@@ -0,0 +1,240 @@
+package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// ListOrganizations will return the organizations the authenticated user belongs to,
+// the organization_id of the returned organizations is required by all other endpoints
+// https://www.zoho.com/books/api/v3/organizations/#list-organizations
+func (c *API) ListOrganizations() (data ListOrganizationsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         OrganizationsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, OrganizationsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListOrganizationsResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListOrganizationsResponse{}, fmt.Errorf("Failed to retrieve organizations: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListOrganizationsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve organizations: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListOrganizationsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListOrganizationsResponse'",
+	)
+}
+
+// GetOrganization will return the organization specified by id
+// https://www.zoho.com/books/api/v3/organizations/#get-an-organization
+func (c *API) GetOrganization(id string) (data OrganizationResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: OrganizationsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			OrganizationsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &OrganizationResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return OrganizationResponse{}, fmt.Errorf(
+			"Failed to retrieve organization (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*OrganizationResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve organization (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return OrganizationResponse{}, fmt.Errorf("Data retrieved was not 'OrganizationResponse'")
+}
+
+// CreateOrganization will create a new organization for the authenticated user
+// https://www.zoho.com/books/api/v3/organizations/#create-an-organization
+func (c *API) CreateOrganization(
+	request OrganizationRequest,
+) (data OrganizationResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         OrganizationsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, OrganizationsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &OrganizationResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return OrganizationResponse{}, fmt.Errorf("Failed to create organization: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*OrganizationResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create organization: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return OrganizationResponse{}, fmt.Errorf("Data retrieved was not 'OrganizationResponse'")
+}
+
+// UpdateOrganization will modify the organization specified by id
+// https://www.zoho.com/books/api/v3/organizations/#update-an-organization
+func (c *API) UpdateOrganization(
+	id string,
+	request OrganizationRequest,
+) (data OrganizationResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: OrganizationsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			OrganizationsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &OrganizationResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return OrganizationResponse{}, fmt.Errorf("Failed to update organization (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*OrganizationResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update organization (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return OrganizationResponse{}, fmt.Errorf("Data retrieved was not 'OrganizationResponse'")
+}
+
+// ResolveOrganizationID will set the organization used by all other endpoints when none has been
+// provided with zoho.SetOrganizationID, the default organization of the authenticated user is
+// chosen, or the only organization when the user belongs to a single one
+func (c *API) ResolveOrganizationID() (id string, err error) {
+	if c.OrganizationID != "" {
+		return c.OrganizationID, nil
+	}
+
+	organizations, err := c.ListOrganizations()
+	if err != nil {
+		return "", err
+	}
+
+	for _, o := range organizations.Organizations {
+		if o.IsDefaultOrg || len(organizations.Organizations) == 1 {
+			c.SetOrganizationID(o.OrganizationID)
+			return o.OrganizationID, nil
+		}
+	}
+
+	return "", fmt.Errorf(
+		"Failed to resolve organization: %d organizations found and none is the default",
+		len(organizations.Organizations),
+	)
+}
+
+// OrganizationRequest is the data provided to CreateOrganization and UpdateOrganization
+type OrganizationRequest struct {
+	Name                 string               `json:"name,omitempty"`
+	FiscalYearStartMonth string               `json:"fiscal_year_start_month,omitempty"`
+	CurrencyCode         string               `json:"currency_code,omitempty"`
+	TimeZone             string               `json:"time_zone,omitempty"`
+	DateFormat           string               `json:"date_format,omitempty"`
+	FieldSeparator       string               `json:"field_separator,omitempty"`
+	LanguageCode         string               `json:"language_code,omitempty"`
+	IndustryType         string               `json:"industry_type,omitempty"`
+	IndustrySize         string               `json:"industry_size,omitempty"`
+	PortalName           string               `json:"portal_name,omitempty"`
+	OrgAddress           string               `json:"org_address,omitempty"`
+	RemitToAddress       string               `json:"remit_to_address,omitempty"`
+	Address              *Address             `json:"address,omitempty"`
+	ContactName          string               `json:"contact_name,omitempty"`
+	Email                string               `json:"email,omitempty"`
+	Phone                string               `json:"phone,omitempty"`
+	Fax                  string               `json:"fax,omitempty"`
+	Website              string               `json:"website,omitempty"`
+	CustomFields         []CustomFieldRequest `json:"custom_fields,omitempty"`
+}
+
+// Organization is an organization (company) in Zoho Books
+type Organization struct {
+	OrganizationID              string        `json:"organization_id"`
+	Name                        string        `json:"name"`
+	ContactName                 string        `json:"contact_name"`
+	Email                       string        `json:"email"`
+	IsDefaultOrg                bool          `json:"is_default_org"`
+	IsOrgActive                 bool          `json:"is_org_active"`
+	AccountCreatedDate          zoho.Date     `json:"account_created_date"`
+	Version                     string        `json:"version"`
+	PlanType                    int64         `json:"plan_type"`
+	PlanName                    string        `json:"plan_name"`
+	PlanPeriod                  string        `json:"plan_period"`
+	LanguageCode                string        `json:"language_code"`
+	FiscalYearStartMonth        int64         `json:"fiscal_year_start_month"`
+	AccountCreatedDateFormatted string        `json:"account_created_date_formatted"`
+	TimeZone                    string        `json:"time_zone"`
+	TimeZoneFormatted           string        `json:"time_zone_formatted"`
+	DateFormat                  string        `json:"date_format"`
+	FieldSeparator              string        `json:"field_separator"`
+	IndustryType                string        `json:"industry_type"`
+	IndustrySize                string        `json:"industry_size"`
+	CompanyIDLabel              string        `json:"company_id_label"`
+	CompanyIDValue              string        `json:"company_id_value"`
+	TaxIDLabel                  string        `json:"tax_id_label"`
+	TaxIDValue                  string        `json:"tax_id_value"`
+	CurrencyID                  string        `json:"currency_id"`
+	CurrencyCode                string        `json:"currency_code"`
+	CurrencySymbol              string        `json:"currency_symbol"`
+	CurrencyFormat              string        `json:"currency_format"`
+	PricePrecision              int64         `json:"price_precision"`
+	Address                     Address       `json:"address"`
+	OrgAddress                  string        `json:"org_address"`
+	RemitToAddress              string        `json:"remit_to_address"`
+	Phone                       string        `json:"phone"`
+	Fax                         string        `json:"fax"`
+	Website                     string        `json:"website"`
+	PortalName                  string        `json:"portal_name"`
+	CustomFields                []CustomField `json:"custom_fields"`
+	IsLogoUploaded              bool          `json:"is_logo_uploaded"`
+}
+
+// OrganizationResponse is the data returned by GetOrganization, CreateOrganization and UpdateOrganization
+type OrganizationResponse struct {
+	Code         int64        `json:"code"`
+	Message      string       `json:"message"`
+	Organization Organization `json:"organization"`
+}
+
+// ListOrganizationsResponse is the data returned by ListOrganizations
+type ListOrganizationsResponse struct {
+	Code          int64          `json:"code"`
+	Message       string         `json:"message"`
+	Organizations []Organization `json:"organizations"`
+}
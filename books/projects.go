@@ -1 +1,511 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateProject will create a new project for a customer
+// https://www.zoho.com/books/api/v3/projects/#create-a-project
+func (c *API) CreateProject(request ProjectRequest) (data ProjectResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         ProjectsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, ProjectsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ProjectResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ProjectResponse{}, fmt.Errorf("Failed to create project: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create project: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ProjectResponse{}, fmt.Errorf("Data retrieved was not 'ProjectResponse'")
+}
+
+// GetProject will return the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#get-a-project
+func (c *API) GetProject(id string) (data ProjectResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ProjectResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ProjectResponse{}, fmt.Errorf("Failed to retrieve project (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ProjectResponse{}, fmt.Errorf("Data retrieved was not 'ProjectResponse'")
+}
+
+// ListProjects will return the list of projects matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/projects/#list-projects
+func (c *API) ListProjects(
+	params map[string]zoho.Parameter,
+) (data ListProjectsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         ProjectsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, ProjectsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListProjectsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"customer_id": "",
+			"filter_by":   "", // Status.All, Status.Active, Status.Inactive
+			"sort_column": "",
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListProjectsResponse{}, fmt.Errorf("Failed to retrieve projects: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListProjectsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve projects: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListProjectsResponse{}, fmt.Errorf("Data retrieved was not 'ListProjectsResponse'")
+}
+
+// UpdateProject will modify the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#update-project
+func (c *API) UpdateProject(id string, request ProjectRequest) (data ProjectResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &ProjectResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ProjectResponse{}, fmt.Errorf("Failed to update project (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ProjectResponse{}, fmt.Errorf("Data retrieved was not 'ProjectResponse'")
+}
+
+// DeleteProject will delete the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#delete-project
+func (c *API) DeleteProject(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete project (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ActivateProject will mark the project specified by id as active
+// https://www.zoho.com/books/api/v3/projects/#activate-project
+func (c *API) ActivateProject(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/active",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to activate project (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to activate project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// InactivateProject will mark the project specified by id as inactive
+// https://www.zoho.com/books/api/v3/projects/#inactivate-a-project
+func (c *API) InactivateProject(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/inactive",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to inactivate project (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to inactivate project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// AssignUsersToProject will add users to the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#assign-users
+func (c *API) AssignUsersToProject(
+	id string,
+	request AssignProjectUsersRequest,
+) (data ProjectUsersResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/users",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ProjectUsersResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ProjectUsersResponse{}, fmt.Errorf(
+			"Failed to assign users to project (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUsersResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to assign users to project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ProjectUsersResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUsersResponse'")
+}
+
+// ListProjectUsers will return the users assigned to the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#list-users
+func (c *API) ListProjectUsers(id string) (data ProjectUsersResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/users",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ProjectUsersResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ProjectUsersResponse{}, fmt.Errorf(
+			"Failed to retrieve users of project (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUsersResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve users of project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ProjectUsersResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUsersResponse'")
+}
+
+// UpdateProjectUser will modify the role and rates of a user assigned to the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#update-user
+func (c *API) UpdateProjectUser(
+	id string,
+	userID string,
+	request ProjectUser,
+) (data ProjectUserResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/users/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+			userID,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &ProjectUserResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ProjectUserResponse{}, fmt.Errorf(
+			"Failed to update user of project (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProjectUserResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update user of project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ProjectUserResponse{}, fmt.Errorf("Data retrieved was not 'ProjectUserResponse'")
+}
+
+// DeleteProjectUser will remove a user from the project specified by id
+// https://www.zoho.com/books/api/v3/projects/#delete-user
+func (c *API) DeleteProjectUser(id string, userID string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/users/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			id,
+			userID,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to remove user from project (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to remove user from project (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ProjectBillingType is the method used to bill a customer for a project
+type ProjectBillingType string
+
+// Proper names for Project billing types
+const (
+	ProjectBillingFixedCost    ProjectBillingType = "fixed_cost_for_project"
+	ProjectBillingProjectHours ProjectBillingType = "based_on_project_hours"
+	ProjectBillingTaskHours    ProjectBillingType = "based_on_task_hours"
+	ProjectBillingStaffHours   ProjectBillingType = "based_on_staff_hours"
+)
+
+// ProjectRequest is the data provided to CreateProject and UpdateProject
+type ProjectRequest struct {
+	ProjectName string             `json:"project_name"`
+	CustomerID  string             `json:"customer_id"`
+	CurrencyID  string             `json:"currency_id,omitempty"`
+	Description string             `json:"description,omitempty"`
+	BillingType ProjectBillingType `json:"billing_type"`
+	// Rate is the fixed cost of the project or the hourly rate when billed on project hours
+	Rate             *zoho.Money   `json:"rate,omitempty"`
+	BudgetType       string        `json:"budget_type,omitempty"` // total_project_cost, total_project_hours, hours_per_task, hours_per_staff
+	BudgetHours      string        `json:"budget_hours,omitempty"`
+	BudgetAmount     *zoho.Money   `json:"budget_amount,omitempty"`
+	CostBudgetAmount *zoho.Money   `json:"cost_budget_amount,omitempty"`
+	UserID           string        `json:"user_id,omitempty"`
+	Tasks            []TaskRequest `json:"tasks,omitempty"`
+	Users            []ProjectUser `json:"users,omitempty"`
+}
+
+// ProjectUser is a user assigned to a project along with their rates
+type ProjectUser struct {
+	UserID        string `json:"user_id"`
+	UserName      string `json:"user_name,omitempty"`
+	Email         string `json:"email,omitempty"`
+	UserRole      string `json:"user_role,omitempty"`
+	IsCurrentUser bool   `json:"is_current_user,omitempty"`
+	Status        string `json:"status,omitempty"`
+	// Rate is the hourly rate of the user when the project is billed on staff hours
+	Rate        *zoho.Money `json:"rate,omitempty"`
+	BudgetHours string      `json:"budget_hours,omitempty"`
+	CostRate    *zoho.Money `json:"cost_rate,omitempty"`
+}
+
+// Project is a project as returned by the Books API
+type Project struct {
+	ProjectID        string             `json:"project_id"`
+	ProjectName      string             `json:"project_name"`
+	Description      string             `json:"description"`
+	Status           string             `json:"status"`
+	CustomerID       string             `json:"customer_id"`
+	CustomerName     string             `json:"customer_name"`
+	CurrencyID       string             `json:"currency_id"`
+	CurrencyCode     string             `json:"currency_code"`
+	BillingType      ProjectBillingType `json:"billing_type"`
+	Rate             zoho.Money         `json:"rate"`
+	BudgetType       string             `json:"budget_type"`
+	BudgetHours      string             `json:"budget_hours"`
+	BudgetAmount     zoho.Money         `json:"budget_amount"`
+	TotalHours       string             `json:"total_hours"`
+	BillableHours    string             `json:"billable_hours"`
+	BilledHours      string             `json:"billed_hours"`
+	UnBilledHours    string             `json:"un_billed_hours"`
+	Tasks            []Task             `json:"tasks"`
+	Users            []ProjectUser      `json:"users"`
+	CreatedTime      zoho.Time          `json:"created_time"`
+	LastModifiedTime zoho.Time          `json:"last_modified_time"`
+}
+
+// ProjectResponse is the data returned by CreateProject, GetProject and UpdateProject
+type ProjectResponse struct {
+	Code    int64   `json:"code"`
+	Message string  `json:"message"`
+	Project Project `json:"project"`
+}
+
+// ListProjectsResponse is the data returned by ListProjects
+type ListProjectsResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Projects    []Project   `json:"projects"`
+	PageContext PageContext `json:"page_context"`
+}
+
+// AssignProjectUsersRequest is the data provided to AssignUsersToProject
+type AssignProjectUsersRequest struct {
+	Users []ProjectUser `json:"users"`
+}
+
+// ProjectUsersResponse is the data returned by AssignUsersToProject and ListProjectUsers
+type ProjectUsersResponse struct {
+	Code    int64         `json:"code"`
+	Message string        `json:"message"`
+	Users   []ProjectUser `json:"users"`
+}
+
+// ProjectUserResponse is the data returned by UpdateProjectUser
+type ProjectUserResponse struct {
+	Code    int64       `json:"code"`
+	Message string      `json:"message"`
+	User    ProjectUser `json:"user"`
+}
@@ -1 +1,479 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// PurchaseOrderStatus is a status that a purchase order can be moved to
+type PurchaseOrderStatus string
+
+// Proper names for PurchaseOrder status transitions
+const (
+	PurchaseOrderStatusOpen      PurchaseOrderStatus = "open"
+	PurchaseOrderStatusBilled    PurchaseOrderStatus = "billed"
+	PurchaseOrderStatusCancelled PurchaseOrderStatus = "cancelled"
+)
+
+// CreatePurchaseOrder will create a new purchase order for a vendor
+// https://www.zoho.com/books/api/v3/purchase-order/#create-a-purchase-order
+func (c *API) CreatePurchaseOrder(
+	request PurchaseOrderRequest,
+) (data PurchaseOrderResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         PurchaseOrdersModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, PurchaseOrdersModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &PurchaseOrderResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PurchaseOrderResponse{}, fmt.Errorf("Failed to create purchase order: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PurchaseOrderResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create purchase order: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return PurchaseOrderResponse{}, fmt.Errorf("Data retrieved was not 'PurchaseOrderResponse'")
+}
+
+// GetPurchaseOrder will return the purchase order specified by id
+// https://www.zoho.com/books/api/v3/purchase-order/#get-a-purchase-order
+func (c *API) GetPurchaseOrder(id string) (data PurchaseOrderResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: PurchaseOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			PurchaseOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &PurchaseOrderResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PurchaseOrderResponse{}, fmt.Errorf(
+			"Failed to retrieve purchase order (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PurchaseOrderResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve purchase order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return PurchaseOrderResponse{}, fmt.Errorf("Data retrieved was not 'PurchaseOrderResponse'")
+}
+
+// ListPurchaseOrders will return the list of purchase orders matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/purchase-order/#list-purchase-orders
+func (c *API) ListPurchaseOrders(
+	params map[string]zoho.Parameter,
+) (data ListPurchaseOrdersResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         PurchaseOrdersModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, PurchaseOrdersModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListPurchaseOrdersResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"purchaseorder_number": "",
+			"vendor_id":            "",
+			"status":               "", // draft, open, billed, cancelled
+			"filter_by":            "",
+			"search_text":          "",
+			"sort_column":          "",
+			"page":                 "1",
+			"per_page":             "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListPurchaseOrdersResponse{}, fmt.Errorf(
+			"Failed to retrieve purchase orders: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListPurchaseOrdersResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve purchase orders: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListPurchaseOrdersResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListPurchaseOrdersResponse'",
+	)
+}
+
+// UpdatePurchaseOrder will modify the purchase order specified by id
+// https://www.zoho.com/books/api/v3/purchase-order/#update-a-purchase-order
+func (c *API) UpdatePurchaseOrder(
+	id string,
+	request PurchaseOrderRequest,
+) (data PurchaseOrderResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: PurchaseOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			PurchaseOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &PurchaseOrderResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PurchaseOrderResponse{}, fmt.Errorf(
+			"Failed to update purchase order (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PurchaseOrderResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update purchase order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return PurchaseOrderResponse{}, fmt.Errorf("Data retrieved was not 'PurchaseOrderResponse'")
+}
+
+// DeletePurchaseOrder will delete the purchase order specified by id
+// https://www.zoho.com/books/api/v3/purchase-order/#delete-a-purchase-order
+func (c *API) DeletePurchaseOrder(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: PurchaseOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			PurchaseOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete purchase order (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete purchase order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// MarkPurchaseOrderAs will move the purchase order specified by id to the given status
+// https://www.zoho.com/books/api/v3/purchase-order/#mark-a-purchase-order-as-open
+// https://www.zoho.com/books/api/v3/purchase-order/#mark-as-billed
+// https://www.zoho.com/books/api/v3/purchase-order/#cancel-a-purchase-order
+func (c *API) MarkPurchaseOrderAs(
+	id string,
+	status PurchaseOrderStatus,
+) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: PurchaseOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/%s",
+			c.ZohoTLD,
+			PurchaseOrdersModule,
+			id,
+			status,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to mark purchase order (%s) as %s: %s",
+			id,
+			status,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to mark purchase order (%s) as %s: %s",
+				id,
+				status,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// EmailPurchaseOrder will send the purchase order specified by id to the provided recipients
+// https://www.zoho.com/books/api/v3/purchase-order/#email-a-purchase-order
+func (c *API) EmailPurchaseOrder(id string, request EmailRequest) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: PurchaseOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/email",
+			c.ZohoTLD,
+			PurchaseOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to email purchase order (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to email purchase order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ConvertPurchaseOrderToBill will create a bill for the vendor of the purchase order specified by id.
+// The quantities of the purchase order which are not billed yet are copied to the bill and
+// linked back to the purchase order, fields set on request (bill number, dates, etc.) are used
+// for the rest of the bill. The discount and tax inclusiveness of the purchase order are kept,
+// unless a discount is set on request. Cancelled and fully billed purchase orders are refused.
+// https://www.zoho.com/books/api/v3/bills/#create-a-bill
+func (c *API) ConvertPurchaseOrderToBill(
+	id string,
+	request BillRequest,
+) (data BillResponse, err error) {
+	po, err := c.GetPurchaseOrder(id)
+	if err != nil {
+		return BillResponse{}, fmt.Errorf("Failed to convert purchase order (%s) to bill: %s", id, err)
+	}
+
+	switch {
+	case po.PurchaseOrder.Status == "cancelled":
+		return BillResponse{}, fmt.Errorf(
+			"Failed to convert purchase order (%s) to bill: purchase order is cancelled",
+			id,
+		)
+	case po.PurchaseOrder.BilledStatus == "billed":
+		return BillResponse{}, fmt.Errorf(
+			"Failed to convert purchase order (%s) to bill: purchase order is already billed",
+			id,
+		)
+	}
+
+	request.VendorID = po.PurchaseOrder.VendorID
+	request.PurchaseorderIDs = []string{id}
+	if request.CurrencyID == "" {
+		request.CurrencyID = po.PurchaseOrder.CurrencyID
+	}
+	if request.ExchangeRate == 0 {
+		request.ExchangeRate = po.PurchaseOrder.ExchangeRate
+	}
+	if request.Discount == nil {
+		request.Discount = &po.PurchaseOrder.Discount
+		request.IsDiscountBeforeTax = po.PurchaseOrder.IsDiscountBeforeTax
+		request.DiscountType = po.PurchaseOrder.DiscountType
+	}
+	// The rates of the lines are copied as they are, whether or not they include tax
+	request.IsInclusiveTax = po.PurchaseOrder.IsInclusiveTax
+
+	request.LineItems = make([]LineItem, 0, len(po.PurchaseOrder.LineItems))
+	for _, item := range po.PurchaseOrder.LineItems {
+		if item.QuantityBilled >= item.Quantity {
+			continue
+		}
+		line := item.Request()
+		line.Quantity = item.Quantity - item.QuantityBilled
+		line.PurchaseorderItemID = item.LineItemID
+		request.LineItems = append(request.LineItems, line)
+	}
+	if len(request.LineItems) == 0 {
+		return BillResponse{}, fmt.Errorf(
+			"Failed to convert purchase order (%s) to bill: every line is already billed",
+			id,
+		)
+	}
+
+	data, err = c.CreateBill(request)
+	if err != nil {
+		return data, fmt.Errorf("Failed to convert purchase order (%s) to bill: %s", id, err)
+	}
+	return data, nil
+}
+
+// PurchaseOrderRequest is the data provided to CreatePurchaseOrder and UpdatePurchaseOrder
+type PurchaseOrderRequest struct {
+	VendorID              string               `json:"vendor_id"`
+	CurrencyID            string               `json:"currency_id,omitempty"`
+	ContactPersons        []string             `json:"contact_persons,omitempty"`
+	PurchaseorderNumber   string               `json:"purchaseorder_number,omitempty"`
+	ReferenceNumber       string               `json:"reference_number,omitempty"`
+	GstTreatment          string               `json:"gst_treatment,omitempty"`
+	GstNo                 string               `json:"gst_no,omitempty"`
+	SourceOfSupply        string               `json:"source_of_supply,omitempty"`
+	DestinationOfSupply   string               `json:"destination_of_supply,omitempty"`
+	TemplateID            string               `json:"template_id,omitempty"`
+	Date                  *zoho.Date           `json:"date,omitempty"`
+	DeliveryDate          *zoho.Date           `json:"delivery_date,omitempty"`
+	ExchangeRate          float64              `json:"exchange_rate,omitempty"`
+	Discount              float64              `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool                 `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string               `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool                 `json:"is_inclusive_tax,omitempty"`
+	SalesorderID          string               `json:"salesorder_id,omitempty"`
+	DeliveryCustomerID    string               `json:"delivery_customer_id,omitempty"`
+	ShipVia               string               `json:"ship_via,omitempty"`
+	Attention             string               `json:"attention,omitempty"`
+	BillingAddressID      string               `json:"billing_address_id,omitempty"`
+	CustomFields          []CustomFieldRequest `json:"custom_fields,omitempty"`
+	LineItems             []LineItem           `json:"line_items"`
+	Notes                 string               `json:"notes,omitempty"`
+	Terms                 string               `json:"terms,omitempty"`
+	Adjustment            *zoho.Money          `json:"adjustment,omitempty"`
+	AdjustmentDescription string               `json:"adjustment_description,omitempty"`
+}
+
+// PurchaseOrder is a purchase order as returned by the Books API
+type PurchaseOrder struct {
+	PurchaseorderID       string       `json:"purchaseorder_id"`
+	PurchaseorderNumber   string       `json:"purchaseorder_number"`
+	Date                  zoho.Date    `json:"date"`
+	DeliveryDate          zoho.Date    `json:"delivery_date"`
+	ExpectedDeliveryDate  zoho.Date    `json:"expected_delivery_date"`
+	ReferenceNumber       string       `json:"reference_number"`
+	Status                string       `json:"status"`
+	BilledStatus          string       `json:"billed_status"`
+	VendorID              string       `json:"vendor_id"`
+	VendorName            string       `json:"vendor_name"`
+	ContactPersons        []string     `json:"contact_persons"`
+	CurrencyID            string       `json:"currency_id"`
+	CurrencyCode          string       `json:"currency_code"`
+	ExchangeRate          float64      `json:"exchange_rate"`
+	Discount              float64      `json:"discount"`
+	IsDiscountBeforeTax   bool         `json:"is_discount_before_tax"`
+	DiscountType          string       `json:"discount_type"`
+	IsInclusiveTax        bool         `json:"is_inclusive_tax"`
+	SalesorderID          string       `json:"salesorder_id"`
+	LineItems             []LineItem   `json:"line_items"`
+	Adjustment            zoho.Money   `json:"adjustment"`
+	AdjustmentDescription string       `json:"adjustment_description"`
+	SubTotal              zoho.Money   `json:"sub_total"`
+	TaxTotal              zoho.Money   `json:"tax_total"`
+	Total                 zoho.Money   `json:"total"`
+	Taxes                 []TaxSummary `json:"taxes"`
+	PricePrecision        int64        `json:"price_precision"`
+	Bills                 []struct {
+		BillID     string     `json:"bill_id"`
+		BillNumber string     `json:"bill_number"`
+		Status     string     `json:"status"`
+		Date       zoho.Date  `json:"date"`
+		DueDate    zoho.Date  `json:"due_date"`
+		Total      zoho.Money `json:"total"`
+		Balance    zoho.Money `json:"balance"`
+	} `json:"bills"`
+	BillingAddress   Address       `json:"billing_address"`
+	DeliveryAddress  Address       `json:"delivery_address"`
+	ShipVia          string        `json:"ship_via"`
+	Attention        string        `json:"attention"`
+	Notes            string        `json:"notes"`
+	Terms            string        `json:"terms"`
+	CustomFields     []CustomField `json:"custom_fields"`
+	TemplateID       string        `json:"template_id"`
+	TemplateName     string        `json:"template_name"`
+	CreatedTime      zoho.Time     `json:"created_time"`
+	LastModifiedTime zoho.Time     `json:"last_modified_time"`
+}
+
+// PurchaseOrderResponse is the data returned by CreatePurchaseOrder, GetPurchaseOrder and UpdatePurchaseOrder
+type PurchaseOrderResponse struct {
+	Code          int64         `json:"code"`
+	Message       string        `json:"message"`
+	PurchaseOrder PurchaseOrder `json:"purchaseorder"`
+}
+
+// ListPurchaseOrdersResponse is the data returned by ListPurchaseOrders
+type ListPurchaseOrdersResponse struct {
+	Code           int64  `json:"code"`
+	Message        string `json:"message"`
+	PurchaseOrders []struct {
+		PurchaseorderID     string     `json:"purchaseorder_id"`
+		VendorID            string     `json:"vendor_id"`
+		VendorName          string     `json:"vendor_name"`
+		Status              string     `json:"status"`
+		BilledStatus        string     `json:"billed_status"`
+		PurchaseorderNumber string     `json:"purchaseorder_number"`
+		ReferenceNumber     string     `json:"reference_number"`
+		Date                zoho.Date  `json:"date"`
+		DeliveryDate        zoho.Date  `json:"delivery_date"`
+		CurrencyID          string     `json:"currency_id"`
+		CurrencyCode        string     `json:"currency_code"`
+		Total               zoho.Money `json:"total"`
+		CreatedTime         zoho.Time  `json:"created_time"`
+		LastModifiedTime    zoho.Time  `json:"last_modified_time"`
+	} `json:"purchaseorders"`
+	PageContext PageContext `json:"page_context"`
+}
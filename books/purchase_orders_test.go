@@ -0,0 +1,126 @@
+package books
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+const testPurchaseOrder = `{"code":0,"purchaseorder":{
+	"purchaseorder_id":"460000000062001",
+	"status":%q,
+	"billed_status":%q,
+	"vendor_id":"460000000026049",
+	"currency_id":"460000000000099",
+	"discount":5,
+	"is_discount_before_tax":true,
+	"discount_type":"entity_level",
+	"is_inclusive_tax":true,
+	"line_items":[
+		{"line_item_id":"1","item_id":"10","rate":5.00,"quantity":10,"quantity_billed":4,
+			"item_total":50.00},
+		{"line_item_id":"2","item_id":"20","rate":7.00,"quantity":3,"quantity_billed":3},
+		{"line_item_id":"3","item_id":"30","rate":1.25,"quantity":8,"item_total":10.00}
+	]
+}}`
+
+func TestConvertPurchaseOrderToBill(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var created BillRequest
+	s.Handle(
+		http.MethodGet,
+		"/api/v3/purchaseorders/460000000062001",
+		zohotest.Reply(fmt.Sprintf(testPurchaseOrder, "open", "partially_billed")),
+	)
+	s.Handle(http.MethodPost, "/api/v3/bills", func(w http.ResponseWriter, r *http.Request) {
+		zohotest.DecodeJSON(t, r, &created)
+		w.Write([]byte(`{"code":0,"bill":{"bill_id":"460000000062100"}}`))
+	})
+
+	api := New(s.Client())
+	_, err := api.ConvertPurchaseOrderToBill("460000000062001", BillRequest{BillNumber: "B-1"})
+	if err != nil {
+		t.Fatalf("ConvertPurchaseOrderToBill returned error: %s", err)
+	}
+
+	if created.VendorID != "460000000026049" || created.BillNumber != "B-1" ||
+		len(created.PurchaseorderIDs) != 1 || created.PurchaseorderIDs[0] != "460000000062001" {
+		t.Errorf("bill = %+v", created)
+	}
+	if !created.IsInclusiveTax || created.Discount == nil || *created.Discount != 5 ||
+		!created.IsDiscountBeforeTax || created.DiscountType != "entity_level" {
+		t.Errorf("bill tax and discount = %t %v %t %q, want those of the purchase order",
+			created.IsInclusiveTax, created.Discount, created.IsDiscountBeforeTax,
+			created.DiscountType)
+	}
+	if len(created.LineItems) != 2 {
+		t.Fatalf("bill has %d lines, want the 2 lines not fully billed", len(created.LineItems))
+	}
+
+	partial, unbilled := created.LineItems[0], created.LineItems[1]
+	if partial.PurchaseorderItemID != "1" || partial.LineItemID != "" || partial.Quantity != 6 ||
+		partial.ItemTotal != nil || partial.QuantityBilled != 0 {
+		t.Errorf("partially billed line = %+v", partial)
+	}
+	if unbilled.PurchaseorderItemID != "3" || unbilled.Quantity != 8 || unbilled.ItemTotal != nil ||
+		unbilled.Rate == nil || unbilled.Rate.String() != "1.25" {
+		t.Errorf("unbilled line = %+v", unbilled)
+	}
+}
+
+func TestConvertPurchaseOrderToBillDiscount(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var created map[string]interface{}
+	s.Handle(
+		http.MethodGet,
+		"/api/v3/purchaseorders/460000000062001",
+		zohotest.Reply(fmt.Sprintf(testPurchaseOrder, "open", "")),
+	)
+	s.Handle(http.MethodPost, "/api/v3/bills", func(w http.ResponseWriter, r *http.Request) {
+		zohotest.DecodeJSON(t, r, &created)
+		w.Write([]byte(`{"code":0,"bill":{"bill_id":"460000000062100"}}`))
+	})
+
+	// An explicit zero removes the discount of the purchase order
+	discount := 0.0
+	api := New(s.Client())
+	_, err := api.ConvertPurchaseOrderToBill("460000000062001", BillRequest{Discount: &discount})
+	if err != nil {
+		t.Fatalf("ConvertPurchaseOrderToBill returned error: %s", err)
+	}
+	if created["discount"] != 0.0 || created["is_inclusive_tax"] != true {
+		t.Errorf("bill discount = %v is_inclusive_tax = %v, want 0 and true",
+			created["discount"], created["is_inclusive_tax"])
+	}
+}
+
+func TestConvertPurchaseOrderToBillRefused(t *testing.T) {
+	tests := []struct {
+		status       string
+		billedStatus string
+	}{
+		{status: "cancelled", billedStatus: ""},
+		{status: "billed", billedStatus: "billed"},
+	}
+
+	s := zohotest.NewServer(t)
+	defer s.Close()
+	api := New(s.Client())
+
+	for _, tt := range tests {
+		s.Handle(
+			http.MethodGet,
+			"/api/v3/purchaseorders/460000000062001",
+			zohotest.Reply(fmt.Sprintf(testPurchaseOrder, tt.status, tt.billedStatus)),
+		)
+		if _, err := api.ConvertPurchaseOrderToBill("460000000062001", BillRequest{}); err == nil {
+			t.Errorf("%s: ConvertPurchaseOrderToBill returned no error", tt.status)
+		}
+	}
+}
@@ -1 +1,431 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateRecurringExpense will create a new recurring expense
+// https://www.zoho.com/books/api/v3/recurring-expenses/#create-a-recurring-expense
+func (c *API) CreateRecurringExpense(
+	request RecurringExpenseRequest,
+) (data RecurringExpenseResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         RecurringExpensesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, RecurringExpensesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &RecurringExpenseResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecurringExpenseResponse{}, fmt.Errorf("Failed to create recurring expense: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringExpenseResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create recurring expense: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return RecurringExpenseResponse{}, fmt.Errorf(
+		"Data retrieved was not 'RecurringExpenseResponse'",
+	)
+}
+
+// GetRecurringExpense will return the recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#get-a-recurring-expense
+func (c *API) GetRecurringExpense(id string) (data RecurringExpenseResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RecurringExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &RecurringExpenseResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecurringExpenseResponse{}, fmt.Errorf(
+			"Failed to retrieve recurring expense (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringExpenseResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve recurring expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return RecurringExpenseResponse{}, fmt.Errorf(
+		"Data retrieved was not 'RecurringExpenseResponse'",
+	)
+}
+
+// ListRecurringExpenses will return the list of recurring expenses matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/recurring-expenses/#list-recurring-expenses
+func (c *API) ListRecurringExpenses(
+	params map[string]zoho.Parameter,
+) (data ListRecurringExpensesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         RecurringExpensesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, RecurringExpensesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListRecurringExpensesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"recurrence_name":   "",
+			"last_created_date": "",
+			"next_expense_date": "",
+			"status":            "", // active, stopped, expired
+			"account_id":        "",
+			"account_name":      "",
+			"customer_id":       "",
+			"customer_name":     "",
+			"filter_by":         "", // Status.All, Status.Active, Status.Stopped, Status.Expired
+			"search_text":       "",
+			"sort_column":       "",
+			"page":              "1",
+			"per_page":          "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListRecurringExpensesResponse{}, fmt.Errorf(
+			"Failed to retrieve recurring expenses: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListRecurringExpensesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve recurring expenses: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListRecurringExpensesResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListRecurringExpensesResponse'",
+	)
+}
+
+// UpdateRecurringExpense will modify the recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#update-a-recurring-expense
+func (c *API) UpdateRecurringExpense(
+	id string,
+	request RecurringExpenseRequest,
+) (data RecurringExpenseResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RecurringExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &RecurringExpenseResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecurringExpenseResponse{}, fmt.Errorf(
+			"Failed to update recurring expense (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringExpenseResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update recurring expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return RecurringExpenseResponse{}, fmt.Errorf(
+		"Data retrieved was not 'RecurringExpenseResponse'",
+	)
+}
+
+// DeleteRecurringExpense will delete the recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#delete-a-recurring-expense
+func (c *API) DeleteRecurringExpense(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RecurringExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete recurring expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete recurring expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// StopRecurringExpense will stop the recurring expense specified by id from creating further expenses
+// https://www.zoho.com/books/api/v3/recurring-expenses/#stop-a-recurring-expense
+func (c *API) StopRecurringExpense(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/stop",
+			c.ZohoTLD,
+			RecurringExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to stop recurring expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to stop recurring expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ResumeRecurringExpense will resume creating expenses from the stopped recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#resume-a-recurring-expense
+func (c *API) ResumeRecurringExpense(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/resume",
+			c.ZohoTLD,
+			RecurringExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to resume recurring expense (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to resume recurring expense (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ListChildExpenses will return the expenses created by the recurring expense specified by id
+// https://www.zoho.com/books/api/v3/recurring-expenses/#list-child-expenses-created
+func (c *API) ListChildExpenses(
+	id string,
+	params map[string]zoho.Parameter,
+) (data ListChildExpensesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringExpensesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/expenses",
+			c.ZohoTLD,
+			RecurringExpensesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListChildExpensesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"sort_column": "", // next_expense_date, account_name, total, last_created_date, recurrence_name, customer_name, created_time
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListChildExpensesResponse{}, fmt.Errorf(
+			"Failed to retrieve child expenses of recurring expense (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListChildExpensesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve child expenses of recurring expense (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ListChildExpensesResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListChildExpensesResponse'",
+	)
+}
+
+// RecurringExpenseRequest is the data provided to CreateRecurringExpense and UpdateRecurringExpense
+type RecurringExpenseRequest struct {
+	AccountID            string               `json:"account_id,omitempty"`
+	PaidThroughAccountID string               `json:"paid_through_account_id"`
+	RecurrenceName       string               `json:"recurrence_name"`
+	StartDate            zoho.Date            `json:"start_date"`
+	EndDate              *zoho.Date           `json:"end_date,omitempty"`
+	RecurrenceFrequency  string               `json:"recurrence_frequency"` // days, weeks, months, years
+	RepeatEvery          int64                `json:"repeat_every"`
+	Amount               *zoho.Money          `json:"amount,omitempty"`
+	TaxID                string               `json:"tax_id,omitempty"`
+	IsInclusiveTax       bool                 `json:"is_inclusive_tax,omitempty"`
+	IsBillable           bool                 `json:"is_billable,omitempty"`
+	CustomerID           string               `json:"customer_id,omitempty"`
+	VendorID             string               `json:"vendor_id,omitempty"`
+	ProjectID            string               `json:"project_id,omitempty"`
+	CurrencyID           string               `json:"currency_id,omitempty"`
+	ExchangeRate         float64              `json:"exchange_rate,omitempty"`
+	Description          string               `json:"description,omitempty"`
+	LineItems            []ExpenseLineItem    `json:"line_items,omitempty"`
+	CustomFields         []CustomFieldRequest `json:"custom_fields,omitempty"`
+}
+
+// RecurringExpense is a recurring expense as returned by the Books API
+type RecurringExpense struct {
+	RecurringExpenseID     string            `json:"recurring_expense_id"`
+	RecurrenceName         string            `json:"recurrence_name"`
+	Status                 string            `json:"status"`
+	StartDate              zoho.Date         `json:"start_date"`
+	EndDate                zoho.Date         `json:"end_date"`
+	LastCreatedDate        zoho.Date         `json:"last_created_date"`
+	NextExpenseDate        zoho.Date         `json:"next_expense_date"`
+	RecurrenceFrequency    string            `json:"recurrence_frequency"`
+	RepeatEvery            int64             `json:"repeat_every"`
+	AccountID              string            `json:"account_id"`
+	AccountName            string            `json:"account_name"`
+	PaidThroughAccountID   string            `json:"paid_through_account_id"`
+	PaidThroughAccountName string            `json:"paid_through_account_name"`
+	VendorID               string            `json:"vendor_id"`
+	VendorName             string            `json:"vendor_name"`
+	CustomerID             string            `json:"customer_id"`
+	CustomerName           string            `json:"customer_name"`
+	ProjectID              string            `json:"project_id"`
+	CurrencyID             string            `json:"currency_id"`
+	CurrencyCode           string            `json:"currency_code"`
+	ExchangeRate           float64           `json:"exchange_rate"`
+	TaxID                  string            `json:"tax_id"`
+	TaxName                string            `json:"tax_name"`
+	TaxPercentage          float64           `json:"tax_percentage"`
+	Amount                 zoho.Money        `json:"amount"`
+	SubTotal               zoho.Money        `json:"sub_total"`
+	Total                  zoho.Money        `json:"total"`
+	IsInclusiveTax         bool              `json:"is_inclusive_tax"`
+	IsBillable             bool              `json:"is_billable"`
+	Description            string            `json:"description"`
+	LineItems              []ExpenseLineItem `json:"line_items"`
+	CustomFields           []CustomField     `json:"custom_fields"`
+	CreatedTime            zoho.Time         `json:"created_time"`
+	LastModifiedTime       zoho.Time         `json:"last_modified_time"`
+}
+
+// RecurringExpenseResponse is the data returned by CreateRecurringExpense, GetRecurringExpense and UpdateRecurringExpense
+type RecurringExpenseResponse struct {
+	Code             int64            `json:"code"`
+	Message          string           `json:"message"`
+	RecurringExpense RecurringExpense `json:"recurring_expense"`
+}
+
+// ListRecurringExpensesResponse is the data returned by ListRecurringExpenses
+type ListRecurringExpensesResponse struct {
+	Code              int64              `json:"code"`
+	Message           string             `json:"message"`
+	RecurringExpenses []RecurringExpense `json:"recurring_expenses"`
+	PageContext       PageContext        `json:"page_context"`
+}
+
+// ListChildExpensesResponse is the data returned by ListChildExpenses
+type ListChildExpensesResponse struct {
+	Code           int64  `json:"code"`
+	Message        string `json:"message"`
+	ExpenseHistory []struct {
+		ExpenseID              string     `json:"expense_id"`
+		Date                   zoho.Date  `json:"date"`
+		AccountName            string     `json:"account_name"`
+		VendorName             string     `json:"vendor_name"`
+		PaidThroughAccountName string     `json:"paid_through_account_name"`
+		CustomerName           string     `json:"customer_name"`
+		Total                  zoho.Money `json:"total"`
+		Status                 string     `json:"status"`
+	} `json:"expensehistory"`
+	PageContext PageContext `json:"page_context"`
+}
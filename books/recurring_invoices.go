@@ -1 +1,528 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateRecurringInvoice will create a new recurring invoice for a customer
+// https://www.zoho.com/books/api/v3/recurring-invoices/#create-a-recurring-invoice
+func (c *API) CreateRecurringInvoice(
+	request RecurringInvoiceRequest,
+) (data RecurringInvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         RecurringInvoicesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, RecurringInvoicesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &RecurringInvoiceResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecurringInvoiceResponse{}, fmt.Errorf("Failed to create recurring invoice: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create recurring invoice: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return RecurringInvoiceResponse{}, fmt.Errorf(
+		"Data retrieved was not 'RecurringInvoiceResponse'",
+	)
+}
+
+// GetRecurringInvoice will return the recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#get-a-recurring-invoice
+func (c *API) GetRecurringInvoice(id string) (data RecurringInvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RecurringInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &RecurringInvoiceResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecurringInvoiceResponse{}, fmt.Errorf(
+			"Failed to retrieve recurring invoice (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve recurring invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return RecurringInvoiceResponse{}, fmt.Errorf(
+		"Data retrieved was not 'RecurringInvoiceResponse'",
+	)
+}
+
+// ListRecurringInvoices will return the list of recurring invoices matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/recurring-invoices/#list-all-recurring-invoice
+func (c *API) ListRecurringInvoices(
+	params map[string]zoho.Parameter,
+) (data ListRecurringInvoicesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         RecurringInvoicesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, RecurringInvoicesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListRecurringInvoicesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"recurrence_name": "",
+			"customer_name":   "",
+			"customer_id":     "",
+			"status":          "", // active, stopped, expired
+			"filter_by":       "", // Status.All, Status.Active, Status.Stopped, Status.Expired
+			"search_text":     "",
+			"sort_column":     "",
+			"page":            "1",
+			"per_page":        "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListRecurringInvoicesResponse{}, fmt.Errorf(
+			"Failed to retrieve recurring invoices: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListRecurringInvoicesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve recurring invoices: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListRecurringInvoicesResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListRecurringInvoicesResponse'",
+	)
+}
+
+// UpdateRecurringInvoice will modify the recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#update-recurring-invoice
+func (c *API) UpdateRecurringInvoice(
+	id string,
+	request RecurringInvoiceRequest,
+) (data RecurringInvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RecurringInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &RecurringInvoiceResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecurringInvoiceResponse{}, fmt.Errorf(
+			"Failed to update recurring invoice (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update recurring invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return RecurringInvoiceResponse{}, fmt.Errorf(
+		"Data retrieved was not 'RecurringInvoiceResponse'",
+	)
+}
+
+// DeleteRecurringInvoice will delete the recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#delete-a-recurring-invoice
+func (c *API) DeleteRecurringInvoice(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RecurringInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete recurring invoice (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete recurring invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// StopRecurringInvoice will stop the recurring invoice specified by id from creating further invoices
+// https://www.zoho.com/books/api/v3/recurring-invoices/#stop-a-recurring-invoice
+func (c *API) StopRecurringInvoice(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/stop",
+			c.ZohoTLD,
+			RecurringInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to stop recurring invoice (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to stop recurring invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ResumeRecurringInvoice will resume creating invoices from the stopped recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#resume-a-recurring-invoice
+func (c *API) ResumeRecurringInvoice(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/resume",
+			c.ZohoTLD,
+			RecurringInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to resume recurring invoice (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to resume recurring invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ListRecurringInvoiceHistory will return the history and comments of the recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/recurring-invoices/#list-recurring-invoice-history
+func (c *API) ListRecurringInvoiceHistory(
+	id string,
+) (data RecurringInvoiceHistoryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RecurringInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/comments",
+			c.ZohoTLD,
+			RecurringInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &RecurringInvoiceHistoryResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecurringInvoiceHistoryResponse{}, fmt.Errorf(
+			"Failed to retrieve history of recurring invoice (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecurringInvoiceHistoryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve history of recurring invoice (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return RecurringInvoiceHistoryResponse{}, fmt.Errorf(
+		"Data retrieved was not 'RecurringInvoiceHistoryResponse'",
+	)
+}
+
+// ListChildInvoices will return the invoices created by the recurring invoice specified by id
+// https://www.zoho.com/books/api/v3/invoices/#list-invoices
+func (c *API) ListChildInvoices(
+	id string,
+	params map[string]zoho.Parameter,
+) (data ListChildInvoicesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         InvoicesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, InvoicesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListChildInvoicesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"recurring_invoice_id": zoho.Parameter(id),
+			"sort_column":          "",
+			"page":                 "1",
+			"per_page":             "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListChildInvoicesResponse{}, fmt.Errorf(
+			"Failed to retrieve child invoices of recurring invoice (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListChildInvoicesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve child invoices of recurring invoice (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ListChildInvoicesResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListChildInvoicesResponse'",
+	)
+}
+
+// RecurringInvoiceRequest is the data provided to CreateRecurringInvoice and UpdateRecurringInvoice
+type RecurringInvoiceRequest struct {
+	RecurrenceName        string               `json:"recurrence_name"`
+	ReferenceNumber       string               `json:"reference_number,omitempty"`
+	CustomerID            string               `json:"customer_id"`
+	ContactPersons        []string             `json:"contact_persons,omitempty"`
+	CurrencyID            string               `json:"currency_id,omitempty"`
+	TemplateID            string               `json:"template_id,omitempty"`
+	PlaceOfSupply         string               `json:"place_of_supply,omitempty"`
+	GstTreatment          string               `json:"gst_treatment,omitempty"`
+	GstNo                 string               `json:"gst_no,omitempty"`
+	StartDate             zoho.Date            `json:"start_date"`
+	EndDate               *zoho.Date           `json:"end_date,omitempty"`
+	RecurrenceFrequency   string               `json:"recurrence_frequency"` // days, weeks, months, years
+	RepeatEvery           int64                `json:"repeat_every"`
+	PaymentTerms          int64                `json:"payment_terms,omitempty"`
+	PaymentTermsLabel     string               `json:"payment_terms_label,omitempty"`
+	ExchangeRate          float64              `json:"exchange_rate,omitempty"`
+	Discount              float64              `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool                 `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string               `json:"discount_type,omitempty"`
+	IsInclusiveTax        bool                 `json:"is_inclusive_tax,omitempty"`
+	SalespersonName       string               `json:"salesperson_name,omitempty"`
+	LineItems             []LineItem           `json:"line_items"`
+	PaymentOptions        *PaymentOptions      `json:"payment_options,omitempty"`
+	AllowPartialPayments  bool                 `json:"allow_partial_payments,omitempty"`
+	CustomFields          []CustomFieldRequest `json:"custom_fields,omitempty"`
+	Notes                 string               `json:"notes,omitempty"`
+	Terms                 string               `json:"terms,omitempty"`
+	ShippingCharge        *zoho.Money          `json:"shipping_charge,omitempty"`
+	Adjustment            *zoho.Money          `json:"adjustment,omitempty"`
+	AdjustmentDescription string               `json:"adjustment_description,omitempty"`
+	TaxID                 string               `json:"tax_id,omitempty"`
+	TaxExemptionID        string               `json:"tax_exemption_id,omitempty"`
+	TaxAuthorityID        string               `json:"tax_authority_id,omitempty"`
+}
+
+// RecurringInvoice is a recurring invoice as returned by the Books API
+type RecurringInvoice struct {
+	RecurringInvoiceID   string         `json:"recurring_invoice_id"`
+	RecurrenceName       string         `json:"recurrence_name"`
+	ReferenceNumber      string         `json:"reference_number"`
+	Status               string         `json:"status"`
+	StartDate            zoho.Date      `json:"start_date"`
+	EndDate              zoho.Date      `json:"end_date"`
+	LastSentDate         zoho.Date      `json:"last_sent_date"`
+	NextInvoiceDate      zoho.Date      `json:"next_invoice_date"`
+	RecurrenceFrequency  string         `json:"recurrence_frequency"`
+	RepeatEvery          int64          `json:"repeat_every"`
+	PaymentTerms         int64          `json:"payment_terms"`
+	PaymentTermsLabel    string         `json:"payment_terms_label"`
+	CustomerID           string         `json:"customer_id"`
+	CustomerName         string         `json:"customer_name"`
+	ContactPersons       []string       `json:"contact_persons"`
+	CurrencyID           string         `json:"currency_id"`
+	CurrencyCode         string         `json:"currency_code"`
+	ExchangeRate         float64        `json:"exchange_rate"`
+	Discount             float64        `json:"discount"`
+	IsDiscountBeforeTax  bool           `json:"is_discount_before_tax"`
+	DiscountType         string         `json:"discount_type"`
+	IsInclusiveTax       bool           `json:"is_inclusive_tax"`
+	LineItems            []LineItem     `json:"line_items"`
+	ShippingCharge       zoho.Money     `json:"shipping_charge"`
+	Adjustment           zoho.Money     `json:"adjustment"`
+	SubTotal             zoho.Money     `json:"sub_total"`
+	TaxTotal             zoho.Money     `json:"tax_total"`
+	Total                zoho.Money     `json:"total"`
+	Taxes                []TaxSummary   `json:"taxes"`
+	PaymentOptions       PaymentOptions `json:"payment_options"`
+	AllowPartialPayments bool           `json:"allow_partial_payments"`
+	BillingAddress       Address        `json:"billing_address"`
+	ShippingAddress      Address        `json:"shipping_address"`
+	SalespersonID        string         `json:"salesperson_id"`
+	SalespersonName      string         `json:"salesperson_name"`
+	Notes                string         `json:"notes"`
+	Terms                string         `json:"terms"`
+	CustomFields         []CustomField  `json:"custom_fields"`
+	TemplateID           string         `json:"template_id"`
+	TemplateName         string         `json:"template_name"`
+	CreatedTime          zoho.Time      `json:"created_time"`
+	LastModifiedTime     zoho.Time      `json:"last_modified_time"`
+}
+
+// RecurringInvoiceResponse is the data returned by CreateRecurringInvoice, GetRecurringInvoice and UpdateRecurringInvoice
+type RecurringInvoiceResponse struct {
+	Code             int64            `json:"code"`
+	Message          string           `json:"message"`
+	RecurringInvoice RecurringInvoice `json:"recurring_invoice"`
+}
+
+// ListRecurringInvoicesResponse is the data returned by ListRecurringInvoices
+type ListRecurringInvoicesResponse struct {
+	Code              int64  `json:"code"`
+	Message           string `json:"message"`
+	RecurringInvoices []struct {
+		RecurringInvoiceID  string     `json:"recurring_invoice_id"`
+		RecurrenceName      string     `json:"recurrence_name"`
+		CustomerID          string     `json:"customer_id"`
+		CustomerName        string     `json:"customer_name"`
+		Status              string     `json:"status"`
+		RecurrenceFrequency string     `json:"recurrence_frequency"`
+		RepeatEvery         int64      `json:"repeat_every"`
+		StartDate           zoho.Date  `json:"start_date"`
+		EndDate             zoho.Date  `json:"end_date"`
+		LastSentDate        zoho.Date  `json:"last_sent_date"`
+		NextInvoiceDate     zoho.Date  `json:"next_invoice_date"`
+		Total               zoho.Money `json:"total"`
+		CreatedTime         zoho.Time  `json:"created_time"`
+		LastModifiedTime    zoho.Time  `json:"last_modified_time"`
+	} `json:"recurring_invoices"`
+	PageContext PageContext `json:"page_context"`
+}
+
+// RecurringInvoiceHistoryResponse is the data returned by ListRecurringInvoiceHistory
+type RecurringInvoiceHistoryResponse struct {
+	Code     int64  `json:"code"`
+	Message  string `json:"message"`
+	Comments []struct {
+		CommentID          string    `json:"comment_id"`
+		RecurringInvoiceID string    `json:"recurring_invoice_id"`
+		Description        string    `json:"description"`
+		CommentedByID      string    `json:"commented_by_id"`
+		CommentedBy        string    `json:"commented_by"`
+		Date               zoho.Date `json:"date"`
+		Time               string    `json:"time"`
+		OperationType      string    `json:"operation_type"`
+		TransactionID      string    `json:"transaction_id"`
+		TransactionType    string    `json:"transaction_type"`
+	} `json:"comments"`
+}
+
+// ListChildInvoicesResponse is the data returned by ListChildInvoices
+type ListChildInvoicesResponse struct {
+	Code     int64  `json:"code"`
+	Message  string `json:"message"`
+	Invoices []struct {
+		InvoiceID          string     `json:"invoice_id"`
+		InvoiceNumber      string     `json:"invoice_number"`
+		RecurringInvoiceID string     `json:"recurring_invoice_id"`
+		CustomerID         string     `json:"customer_id"`
+		CustomerName       string     `json:"customer_name"`
+		Status             string     `json:"status"`
+		Date               zoho.Date  `json:"date"`
+		DueDate            zoho.Date  `json:"due_date"`
+		CurrencyCode       string     `json:"currency_code"`
+		Total              zoho.Money `json:"total"`
+		Balance            zoho.Money `json:"balance"`
+		CreatedTime        zoho.Time  `json:"created_time"`
+	} `json:"invoices"`
+	PageContext PageContext `json:"page_context"`
+}
@@ -0,0 +1,639 @@
+package books
+
+import (
+	"fmt"
+	"strconv"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// ComparePeriod is the kind of period a report is compared against
+type ComparePeriod string
+
+// Proper names for the periods a report can be compared against
+const (
+	NoComparison    ComparePeriod = ""
+	PreviousPeriod  ComparePeriod = "previous_period"
+	PreviousYear    ComparePeriod = "previous_year"
+	PreviousQuarter ComparePeriod = "previous_quarter"
+	PreviousMonth   ComparePeriod = "previous_month"
+)
+
+// ReportBasis is the accounting basis used to compute a report
+type ReportBasis string
+
+// Proper names for the accounting basis of a report
+const (
+	AccrualBasis ReportBasis = "Accrual"
+	CashBasis    ReportBasis = "Cash"
+)
+
+// ReportPeriod is the date range of a report and the periods it should be compared against,
+// dates are formatted as yyyy-mm-dd and must be both set for a custom range or both left empty
+type ReportPeriod struct {
+	FromDate     string
+	ToDate       string
+	CompareWith  ComparePeriod
+	CompareCount int
+	Basis        ReportBasis
+}
+
+// parameters returns the URL parameters of the period, a custom date range requires both
+// FromDate and ToDate
+func (p ReportPeriod) parameters() (map[string]zoho.Parameter, error) {
+	if (p.FromDate == "") != (p.ToDate == "") {
+		return nil, fmt.Errorf("custom period requires both a from and a to date")
+	}
+
+	params := map[string]zoho.Parameter{
+		"from_date":    zoho.Parameter(p.FromDate),
+		"to_date":      zoho.Parameter(p.ToDate),
+		"compare_with": zoho.Parameter(p.CompareWith),
+		"report_basis": zoho.Parameter(p.Basis),
+	}
+	if p.FromDate != "" {
+		params["filter_by"] = "TransactionDate.CustomDate"
+	}
+	if p.CompareWith != NoComparison && p.CompareCount > 0 {
+		params["compare_count"] = zoho.Parameter(strconv.Itoa(p.CompareCount))
+	}
+	return params, nil
+}
+
+// AgingPeriod is the date the aging of outstanding amounts is computed at and the size of
+// the intervals they are grouped in, the date is formatted as yyyy-mm-dd
+type AgingPeriod struct {
+	AsOfDate        string
+	IntervalRange   int // days per interval, defaults to 15
+	NumberOfColumns int // number of intervals, defaults to 4
+	Basis           ReportBasis
+}
+
+func (p AgingPeriod) parameters() map[string]zoho.Parameter {
+	params := map[string]zoho.Parameter{
+		"to_date":      zoho.Parameter(p.AsOfDate),
+		"report_basis": zoho.Parameter(p.Basis),
+	}
+	if p.IntervalRange > 0 {
+		params["interval_range"] = zoho.Parameter(strconv.Itoa(p.IntervalRange))
+	}
+	if p.NumberOfColumns > 0 {
+		params["number_of_columns"] = zoho.Parameter(strconv.Itoa(p.NumberOfColumns))
+	}
+	return params
+}
+
+// GetProfitAndLoss will return the profit and loss report for the period, along with the comparison
+// periods requested
+func (c *API) GetProfitAndLoss(
+	period ReportPeriod,
+	params map[string]zoho.Parameter,
+) (data ProfitAndLossResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:          ReportsModule,
+		URL:           fmt.Sprintf("https://books.zoho.%s/api/v3/%s/profitandloss", c.ZohoTLD, ReportsModule),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &ProfitAndLossResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	periodParams, err := period.parameters()
+	if err != nil {
+		return ProfitAndLossResponse{}, fmt.Errorf("Failed to retrieve profit and loss report: %s", err)
+	}
+	for k, v := range periodParams {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ProfitAndLossResponse{}, fmt.Errorf(
+			"Failed to retrieve profit and loss report: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ProfitAndLossResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve profit and loss report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ProfitAndLossResponse{}, fmt.Errorf("Data retrieved was not 'ProfitAndLossResponse'")
+}
+
+// GetBalanceSheet will return the balance sheet as of the end of the period, along with the comparison
+// periods requested
+func (c *API) GetBalanceSheet(
+	period ReportPeriod,
+	params map[string]zoho.Parameter,
+) (data BalanceSheetResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:          ReportsModule,
+		URL:           fmt.Sprintf("https://books.zoho.%s/api/v3/%s/balancesheet", c.ZohoTLD, ReportsModule),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &BalanceSheetResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	periodParams, err := period.parameters()
+	if err != nil {
+		return BalanceSheetResponse{}, fmt.Errorf("Failed to retrieve balance sheet report: %s", err)
+	}
+	for k, v := range periodParams {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BalanceSheetResponse{}, fmt.Errorf(
+			"Failed to retrieve balance sheet report: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BalanceSheetResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve balance sheet report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return BalanceSheetResponse{}, fmt.Errorf("Data retrieved was not 'BalanceSheetResponse'")
+}
+
+// GetCashFlow will return the cash flow statement for the period, along with the comparison
+// periods requested
+func (c *API) GetCashFlow(
+	period ReportPeriod,
+	params map[string]zoho.Parameter,
+) (data CashFlowResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:          ReportsModule,
+		URL:           fmt.Sprintf("https://books.zoho.%s/api/v3/%s/cashflow", c.ZohoTLD, ReportsModule),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &CashFlowResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	periodParams, err := period.parameters()
+	if err != nil {
+		return CashFlowResponse{}, fmt.Errorf("Failed to retrieve cash flow report: %s", err)
+	}
+	for k, v := range periodParams {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CashFlowResponse{}, fmt.Errorf("Failed to retrieve cash flow report: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CashFlowResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve cash flow report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return CashFlowResponse{}, fmt.Errorf("Data retrieved was not 'CashFlowResponse'")
+}
+
+// GetARAgingSummary will return the receivables of each customer grouped by how long they are overdue
+func (c *API) GetARAgingSummary(
+	period AgingPeriod,
+	params map[string]zoho.Parameter,
+) (data AgingSummaryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ReportsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/aragingsummary",
+			c.ZohoTLD,
+			ReportsModule,
+		),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &AgingSummaryResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range period.parameters() {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AgingSummaryResponse{}, fmt.Errorf(
+			"Failed to retrieve AR aging summary report: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AgingSummaryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve AR aging summary report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return AgingSummaryResponse{}, fmt.Errorf("Data retrieved was not 'AgingSummaryResponse'")
+}
+
+// GetAPAgingSummary will return the payables of each vendor grouped by how long they are overdue
+func (c *API) GetAPAgingSummary(
+	period AgingPeriod,
+	params map[string]zoho.Parameter,
+) (data AgingSummaryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ReportsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/apagingsummary",
+			c.ZohoTLD,
+			ReportsModule,
+		),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &AgingSummaryResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range period.parameters() {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AgingSummaryResponse{}, fmt.Errorf(
+			"Failed to retrieve AP aging summary report: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AgingSummaryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve AP aging summary report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return AgingSummaryResponse{}, fmt.Errorf("Data retrieved was not 'AgingSummaryResponse'")
+}
+
+// GetSalesByCustomer will return the sales made to each customer during the period
+func (c *API) GetSalesByCustomer(
+	period ReportPeriod,
+	params map[string]zoho.Parameter,
+) (data SalesByCustomerResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ReportsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/salesbycustomer",
+			c.ZohoTLD,
+			ReportsModule,
+		),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &SalesByCustomerResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	periodParams, err := period.parameters()
+	if err != nil {
+		return SalesByCustomerResponse{}, fmt.Errorf(
+			"Failed to retrieve sales by customer report: %s",
+			err,
+		)
+	}
+	for k, v := range periodParams {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return SalesByCustomerResponse{}, fmt.Errorf(
+			"Failed to retrieve sales by customer report: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesByCustomerResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve sales by customer report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return SalesByCustomerResponse{}, fmt.Errorf("Data retrieved was not 'SalesByCustomerResponse'")
+}
+
+// GetSalesByItem will return the quantity and value sold of each item during the period
+func (c *API) GetSalesByItem(
+	period ReportPeriod,
+	params map[string]zoho.Parameter,
+) (data SalesByItemResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:          ReportsModule,
+		URL:           fmt.Sprintf("https://books.zoho.%s/api/v3/%s/salesbyitem", c.ZohoTLD, ReportsModule),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &SalesByItemResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	periodParams, err := period.parameters()
+	if err != nil {
+		return SalesByItemResponse{}, fmt.Errorf("Failed to retrieve sales by item report: %s", err)
+	}
+	for k, v := range periodParams {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return SalesByItemResponse{}, fmt.Errorf("Failed to retrieve sales by item report: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesByItemResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve sales by item report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return SalesByItemResponse{}, fmt.Errorf("Data retrieved was not 'SalesByItemResponse'")
+}
+
+// GetTaxSummary will return the taxable amount and tax collected or paid for each tax during the period
+func (c *API) GetTaxSummary(
+	period ReportPeriod,
+	params map[string]zoho.Parameter,
+) (data TaxSummaryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:          ReportsModule,
+		URL:           fmt.Sprintf("https://books.zoho.%s/api/v3/%s/taxsummary", c.ZohoTLD, ReportsModule),
+		Method:        zoho.HTTPGet,
+		ResponseData:  &TaxSummaryResponse{},
+		URLParameters: map[string]zoho.Parameter{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	periodParams, err := period.parameters()
+	if err != nil {
+		return TaxSummaryResponse{}, fmt.Errorf("Failed to retrieve tax summary report: %s", err)
+	}
+	for k, v := range periodParams {
+		endpoint.URLParameters[k] = v
+	}
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxSummaryResponse{}, fmt.Errorf("Failed to retrieve tax summary report: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxSummaryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve tax summary report: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxSummaryResponse{}, fmt.Errorf("Data retrieved was not 'TaxSummaryResponse'")
+}
+
+// ReportColumn is a period shown as a column of a report, the first column is the
+// requested period followed by the comparison periods
+type ReportColumn struct {
+	Name     string    `json:"name"`
+	FromDate zoho.Date `json:"from_date"`
+	ToDate   zoho.Date `json:"to_date"`
+}
+
+// ReportValue is the amount of a row for one of the comparison periods
+type ReportValue struct {
+	Total    zoho.Money `json:"total"`
+	FromDate zoho.Date  `json:"from_date"`
+	ToDate   zoho.Date  `json:"to_date"`
+}
+
+// ReportRow is a line of a financial statement, either an account or a group of accounts
+// whose own rows are listed in AccountTransactions
+type ReportRow struct {
+	Name                string        `json:"name"`
+	AccountID           string        `json:"account_id,omitempty"`
+	AccountCode         string        `json:"account_code,omitempty"`
+	Total               zoho.Money    `json:"total"`
+	PreviousValues      []ReportValue `json:"previous_values,omitempty"`
+	AccountTransactions []ReportRow   `json:"account_transactions,omitempty"`
+}
+
+// Totals returns the amount of the row for every column of the report, starting
+// with the requested period followed by the comparison periods
+func (r ReportRow) Totals() []zoho.Money {
+	totals := []zoho.Money{r.Total}
+	for _, v := range r.PreviousValues {
+		totals = append(totals, v.Total)
+	}
+	return totals
+}
+
+// Find returns the first row, searched depth first, whose name or account ID matches key
+func (r ReportRow) Find(key string) (ReportRow, bool) {
+	if r.Name == key || (r.AccountID != "" && r.AccountID == key) {
+		return r, true
+	}
+	for _, row := range r.AccountTransactions {
+		if found, ok := row.Find(key); ok {
+			return found, true
+		}
+	}
+	return ReportRow{}, false
+}
+
+// Accounts returns the account rows below r, skipping the rows that only group other rows
+func (r ReportRow) Accounts() []ReportRow {
+	var accounts []ReportRow
+	for _, row := range r.AccountTransactions {
+		if len(row.AccountTransactions) == 0 {
+			accounts = append(accounts, row)
+			continue
+		}
+		accounts = append(accounts, row.Accounts()...)
+	}
+	return accounts
+}
+
+// ReportRows are the top level rows of a financial statement
+type ReportRows []ReportRow
+
+// Find returns the first row, searched depth first, whose name or account ID matches key
+func (rows ReportRows) Find(key string) (ReportRow, bool) {
+	for _, row := range rows {
+		if found, ok := row.Find(key); ok {
+			return found, true
+		}
+	}
+	return ReportRow{}, false
+}
+
+// ReportPageContext describes the period and options a report was computed with
+type ReportPageContext struct {
+	ReportName   string         `json:"report_name"`
+	FromDate     zoho.Date      `json:"from_date"`
+	ToDate       zoho.Date      `json:"to_date"`
+	ReportBasis  string         `json:"report_basis"`
+	CompareWith  string         `json:"compare_with"`
+	CompareCount int64          `json:"compare_count"`
+	Columns      []ReportColumn `json:"comparison_columns"`
+}
+
+// ProfitAndLossResponse is the data returned by GetProfitAndLoss
+type ProfitAndLossResponse struct {
+	Code          int64             `json:"code"`
+	Message       string            `json:"message"`
+	ProfitAndLoss ReportRows        `json:"profit_and_loss"`
+	PageContext   ReportPageContext `json:"page_context"`
+}
+
+// BalanceSheetResponse is the data returned by GetBalanceSheet
+type BalanceSheetResponse struct {
+	Code         int64             `json:"code"`
+	Message      string            `json:"message"`
+	BalanceSheet ReportRows        `json:"balance_sheet"`
+	PageContext  ReportPageContext `json:"page_context"`
+}
+
+// CashFlowResponse is the data returned by GetCashFlow
+type CashFlowResponse struct {
+	Code        int64             `json:"code"`
+	Message     string            `json:"message"`
+	CashFlow    ReportRows        `json:"cash_flow"`
+	PageContext ReportPageContext `json:"page_context"`
+}
+
+// AgingInterval is the amount outstanding for one interval of an aging report
+type AgingInterval struct {
+	Name   string     `json:"name"` // such as "1-15 Days" or "> 45 Days"
+	Amount zoho.Money `json:"amount"`
+}
+
+// AgingRow is the outstanding amount of a single contact in an aging report
+type AgingRow struct {
+	ContactID    string          `json:"contact_id"`
+	ContactName  string          `json:"contact_name"`
+	CurrencyCode string          `json:"currency_code"`
+	Current      zoho.Money      `json:"current"`
+	Intervals    []AgingInterval `json:"intervals"`
+	Total        zoho.Money      `json:"total"`
+	TotalFcy     zoho.Money      `json:"total_fcy"`
+}
+
+// AgingSummaryResponse is the data returned by GetARAgingSummary and GetAPAgingSummary
+type AgingSummaryResponse struct {
+	Code         int64             `json:"code"`
+	Message      string            `json:"message"`
+	AgingSummary []AgingRow        `json:"aging_summary"`
+	PageContext  ReportPageContext `json:"page_context"`
+}
+
+// SalesByCustomerRow is the sales made to a single customer
+type SalesByCustomerRow struct {
+	CustomerID   string     `json:"customer_id"`
+	CustomerName string     `json:"customer_name"`
+	InvoiceCount int64      `json:"invoice_count"`
+	Sales        zoho.Money `json:"sales"`
+	SalesWithTax zoho.Money `json:"sales_with_tax"`
+}
+
+// SalesByCustomerResponse is the data returned by GetSalesByCustomer
+type SalesByCustomerResponse struct {
+	Code            int64                `json:"code"`
+	Message         string               `json:"message"`
+	SalesByCustomer []SalesByCustomerRow `json:"sales_by_customer"`
+	PageContext     ReportPageContext    `json:"page_context"`
+}
+
+// SalesByItemRow is the quantity and value sold of a single item
+type SalesByItemRow struct {
+	ItemID       string     `json:"item_id"`
+	ItemName     string     `json:"item_name"`
+	QuantitySold float64    `json:"quantity_sold"`
+	Amount       zoho.Money `json:"amount"`
+	AveragePrice zoho.Money `json:"average_price"`
+}
+
+// SalesByItemResponse is the data returned by GetSalesByItem
+type SalesByItemResponse struct {
+	Code        int64             `json:"code"`
+	Message     string            `json:"message"`
+	SalesByItem []SalesByItemRow  `json:"sales_by_item"`
+	PageContext ReportPageContext `json:"page_context"`
+}
+
+// TaxSummaryRow is the taxable amount and tax of a single tax
+type TaxSummaryRow struct {
+	TaxID         string     `json:"tax_id"`
+	TaxName       string     `json:"tax_name"`
+	TaxPercentage float64    `json:"tax_percentage"`
+	TaxableAmount zoho.Money `json:"taxable_amount"`
+	TaxAmount     zoho.Money `json:"tax_amount"`
+}
+
+// TaxSummaryResponse is the data returned by GetTaxSummary
+type TaxSummaryResponse struct {
+	Code        int64             `json:"code"`
+	Message     string            `json:"message"`
+	TaxSummary  []TaxSummaryRow   `json:"tax_summary"`
+	PageContext ReportPageContext `json:"page_context"`
+}
@@ -0,0 +1,209 @@
+package books
+
+import (
+	"net/http"
+	"testing"
+
+	zoho "github.com/schmorrison/Zoho"
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestReportPeriodParameters(t *testing.T) {
+	tests := []struct {
+		name    string
+		period  ReportPeriod
+		want    map[string]zoho.Parameter
+		wantErr bool
+	}{
+		{
+			name:   "custom range",
+			period: ReportPeriod{FromDate: "2019-01-01", ToDate: "2019-03-31", Basis: CashBasis},
+			want: map[string]zoho.Parameter{
+				"from_date":    "2019-01-01",
+				"to_date":      "2019-03-31",
+				"filter_by":    "TransactionDate.CustomDate",
+				"compare_with": "",
+				"report_basis": "Cash",
+			},
+		},
+		{
+			name:   "default range",
+			period: ReportPeriod{},
+			want: map[string]zoho.Parameter{
+				"from_date":    "",
+				"to_date":      "",
+				"compare_with": "",
+				"report_basis": "",
+			},
+		},
+		{
+			name: "comparison",
+			period: ReportPeriod{
+				FromDate:     "2019-01-01",
+				ToDate:       "2019-03-31",
+				CompareWith:  PreviousYear,
+				CompareCount: 2,
+			},
+			want: map[string]zoho.Parameter{
+				"from_date":     "2019-01-01",
+				"to_date":       "2019-03-31",
+				"filter_by":     "TransactionDate.CustomDate",
+				"compare_with":  "previous_year",
+				"compare_count": "2",
+				"report_basis":  "",
+			},
+		},
+		{
+			name:   "count without comparison",
+			period: ReportPeriod{CompareCount: 2},
+			want: map[string]zoho.Parameter{
+				"from_date":    "",
+				"to_date":      "",
+				"compare_with": "",
+				"report_basis": "",
+			},
+		},
+		{name: "from date only", period: ReportPeriod{FromDate: "2019-01-01"}, wantErr: true},
+		{name: "to date only", period: ReportPeriod{ToDate: "2019-03-31"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.period.parameters()
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("%s: parameters = %v, want error", tt.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: parameters returned error: %s", tt.name, err)
+			continue
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: parameters = %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+		for k, v := range tt.want {
+			if got[k] != v {
+				t.Errorf("%s: %s = %q, want %q", tt.name, k, got[k], v)
+			}
+		}
+	}
+}
+
+const testProfitAndLoss = `{
+	"code":0,
+	"message":"success",
+	"profit_and_loss":[
+		{"name":"Gross Profit","total":1500.00,"previous_values":[{"total":1200.00,
+			"from_date":"2018-01-01","to_date":"2018-03-31"}],
+		"account_transactions":[
+			{"name":"Operating Income","total":2000.00,"previous_values":[{"total":1600.00}],
+			"account_transactions":[
+				{"name":"Sales","account_id":"460000000000388","account_code":"4000",
+					"total":1800.00,"previous_values":[{"total":1600.00}]},
+				{"name":"Other Charges","account_id":"460000000000391","total":200.00,
+					"previous_values":[{"total":0}]}
+			]},
+			{"name":"Cost of Goods Sold","total":500.00,"previous_values":[{"total":400.00}],
+			"account_transactions":[
+				{"name":"Cost of Goods Sold","account_id":"460000000034003","total":500.00,
+					"previous_values":[{"total":400.00}]}
+			]}
+		]},
+		{"name":"Net Profit/Loss","total":1500.00,"previous_values":[{"total":1200.00}]}
+	],
+	"page_context":{
+		"report_name":"Profit and Loss",
+		"from_date":"2019-01-01",
+		"to_date":"2019-03-31",
+		"report_basis":"Accrual",
+		"compare_with":"previous_year",
+		"compare_count":1,
+		"comparison_columns":[
+			{"name":"Jan 2019 - Mar 2019","from_date":"2019-01-01","to_date":"2019-03-31"},
+			{"name":"Jan 2018 - Mar 2018","from_date":"2018-01-01","to_date":"2018-03-31"}
+		]
+	}
+}`
+
+func TestGetProfitAndLoss(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	path := "/api/v3/reports/profitandloss"
+	s.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("filter_by") != "TransactionDate.CustomDate" || q.Get("from_date") != "2019-01-01" ||
+			q.Get("compare_with") != "previous_year" || q.Get("compare_count") != "1" {
+			t.Errorf("query = %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(testProfitAndLoss))
+	})
+	api := New(s.Client())
+
+	data, err := api.GetProfitAndLoss(ReportPeriod{
+		FromDate:     "2019-01-01",
+		ToDate:       "2019-03-31",
+		CompareWith:  PreviousYear,
+		CompareCount: 1,
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetProfitAndLoss returned error: %s", err)
+	}
+
+	columns := data.PageContext.Columns
+	if len(columns) != 2 || columns[1].FromDate.String() != "2018-01-01" {
+		t.Errorf("columns = %+v, want the period and the previous year", columns)
+	}
+
+	rows := data.ProfitAndLoss
+	if len(rows) != 2 {
+		t.Fatalf("profit_and_loss has %d rows, want 2", len(rows))
+	}
+	gross := rows[0]
+	if totals := gross.Totals(); len(totals) != 2 || totals[0].String() != "1500.00" ||
+		totals[1].String() != "1200.00" {
+		t.Errorf("Gross Profit totals = %v, want [1500.00 1200.00]", totals)
+	}
+
+	// Groups are found before the accounts they contain
+	cogs, ok := rows.Find("Cost of Goods Sold")
+	if !ok || cogs.AccountID != "" || len(cogs.AccountTransactions) != 1 {
+		t.Errorf("Find(Cost of Goods Sold) = %+v, %t, want the group", cogs, ok)
+	}
+	sales, ok := rows.Find("460000000000388")
+	if !ok || sales.Name != "Sales" || sales.AccountCode != "4000" {
+		t.Errorf("Find(460000000000388) = %+v, %t, want the Sales account", sales, ok)
+	}
+	if _, ok := rows.Find("Depreciation"); ok {
+		t.Errorf("Find(Depreciation) found a row missing from the report")
+	}
+	if net, ok := rows.Find("Net Profit/Loss"); !ok || net.Total.String() != "1500.00" {
+		t.Errorf("Find(Net Profit/Loss) = %+v, %t", net, ok)
+	}
+
+	accounts := gross.Accounts()
+	want := []string{"460000000000388", "460000000000391", "460000000034003"}
+	if len(accounts) != len(want) {
+		t.Fatalf("Accounts = %+v, want %v", accounts, want)
+	}
+	for i, account := range accounts {
+		if account.AccountID != want[i] {
+			t.Errorf("Accounts[%d] = %s, want %s", i, account.AccountID, want[i])
+		}
+	}
+	if got := rows[1].Accounts(); len(got) != 0 {
+		t.Errorf("Accounts of a row without accounts = %+v", got)
+	}
+}
+
+func TestGetProfitAndLossPartialPeriod(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	_, err := New(s.Client()).GetProfitAndLoss(ReportPeriod{FromDate: "2019-01-01"}, nil)
+	if err == nil {
+		t.Errorf("GetProfitAndLoss without a to date returned no error")
+	}
+}
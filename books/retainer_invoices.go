@@ -1 +1,502 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateRetainerInvoice will create a new retainer invoice for a customer
+// https://www.zoho.com/books/api/v3/retainer-invoices/#create-a-retainerinvoice
+func (c *API) CreateRetainerInvoice(
+	request RetainerInvoiceRequest,
+) (data RetainerInvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         RetainerInvoicesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, RetainerInvoicesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &RetainerInvoiceResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RetainerInvoiceResponse{}, fmt.Errorf("Failed to create retainer invoice: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RetainerInvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create retainer invoice: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return RetainerInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RetainerInvoiceResponse'")
+}
+
+// GetRetainerInvoice will return the retainer invoice specified by id
+// https://www.zoho.com/books/api/v3/retainer-invoices/#get-a-retainer-invoice
+func (c *API) GetRetainerInvoice(id string) (data RetainerInvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RetainerInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RetainerInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &RetainerInvoiceResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RetainerInvoiceResponse{}, fmt.Errorf(
+			"Failed to retrieve retainer invoice (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RetainerInvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve retainer invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return RetainerInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RetainerInvoiceResponse'")
+}
+
+// ListRetainerInvoices will return the list of retainer invoices matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/retainer-invoices/#list-a-retainer-invoices
+func (c *API) ListRetainerInvoices(
+	params map[string]zoho.Parameter,
+) (data ListRetainerInvoicesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         RetainerInvoicesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, RetainerInvoicesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListRetainerInvoicesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"customer_id": "",
+			"filter_by":   "", // Status.All, Status.Sent, Status.Paid, Status.Void, Status.Unpaid, Status.Draft, Status.PendingApproval, Status.Approved
+			"search_text": "",
+			"sort_column": "",
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListRetainerInvoicesResponse{}, fmt.Errorf(
+			"Failed to retrieve retainer invoices: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListRetainerInvoicesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve retainer invoices: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListRetainerInvoicesResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListRetainerInvoicesResponse'",
+	)
+}
+
+// UpdateRetainerInvoice will modify the retainer invoice specified by id
+// https://www.zoho.com/books/api/v3/retainer-invoices/#update-a-retainerinvoice
+func (c *API) UpdateRetainerInvoice(
+	id string,
+	request RetainerInvoiceRequest,
+) (data RetainerInvoiceResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RetainerInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RetainerInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &RetainerInvoiceResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RetainerInvoiceResponse{}, fmt.Errorf(
+			"Failed to update retainer invoice (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RetainerInvoiceResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update retainer invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return RetainerInvoiceResponse{}, fmt.Errorf("Data retrieved was not 'RetainerInvoiceResponse'")
+}
+
+// DeleteRetainerInvoice will delete the retainer invoice specified by id
+// https://www.zoho.com/books/api/v3/retainer-invoices/#delete-a-retainer-invoice
+func (c *API) DeleteRetainerInvoice(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RetainerInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			RetainerInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete retainer invoice (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete retainer invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// SubmitRetainerInvoice will submit the retainer invoice specified by id for approval
+// https://www.zoho.com/books/api/v3/retainer-invoices/#submit-a-retainer-invoice-for-approval
+func (c *API) SubmitRetainerInvoice(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RetainerInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/submit",
+			c.ZohoTLD,
+			RetainerInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to submit retainer invoice (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to submit retainer invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ApproveRetainerInvoice will approve the retainer invoice specified by id
+// https://www.zoho.com/books/api/v3/retainer-invoices/#approve-a-retainer-invoice
+func (c *API) ApproveRetainerInvoice(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RetainerInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/approve",
+			c.ZohoTLD,
+			RetainerInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to approve retainer invoice (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to approve retainer invoice (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// MarkRetainerInvoiceAsSent will mark the draft retainer invoice specified by id as sent
+// https://www.zoho.com/books/api/v3/retainer-invoices/#mark-a-retainer-invoice-as-sent
+func (c *API) MarkRetainerInvoiceAsSent(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RetainerInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/sent",
+			c.ZohoTLD,
+			RetainerInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to mark retainer invoice (%s) as sent: %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to mark retainer invoice (%s) as sent: %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// MarkRetainerInvoiceAsVoid will mark the retainer invoice specified by id as void
+// https://www.zoho.com/books/api/v3/retainer-invoices/#void-a-retainer-invoice
+func (c *API) MarkRetainerInvoiceAsVoid(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: RetainerInvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/void",
+			c.ZohoTLD,
+			RetainerInvoicesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to mark retainer invoice (%s) as void: %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to mark retainer invoice (%s) as void: %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ApplyRetainerPaymentsToInvoice will apply payments received against retainer invoices to the regular invoice specified by invoiceID.
+// Each payment is identified by the payment ID recorded on the paid retainer invoice.
+// https://www.zoho.com/books/api/v3/invoices/#apply-credits
+func (c *API) ApplyRetainerPaymentsToInvoice(
+	invoiceID string,
+	request ApplyRetainerPaymentsRequest,
+) (data ApplyRetainerPaymentsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: InvoicesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/credits",
+			c.ZohoTLD,
+			InvoicesModule,
+			invoiceID,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ApplyRetainerPaymentsResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ApplyRetainerPaymentsResponse{}, fmt.Errorf(
+			"Failed to apply retainer payments to invoice (%s): %s",
+			invoiceID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ApplyRetainerPaymentsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to apply retainer payments to invoice (%s): %s",
+				invoiceID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ApplyRetainerPaymentsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ApplyRetainerPaymentsResponse'",
+	)
+}
+
+// RetainerInvoiceRequest is the data provided to CreateRetainerInvoice and UpdateRetainerInvoice
+type RetainerInvoiceRequest struct {
+	CustomerID      string               `json:"customer_id"`
+	ReferenceNumber string               `json:"reference_number,omitempty"`
+	Date            *zoho.Date           `json:"date,omitempty"`
+	ContactPersons  []string             `json:"contact_persons,omitempty"`
+	CurrencyID      string               `json:"currency_id,omitempty"`
+	ExchangeRate    float64              `json:"exchange_rate,omitempty"`
+	TemplateID      string               `json:"template_id,omitempty"`
+	ProjectID       string               `json:"project_id,omitempty"`
+	PlaceOfSupply   string               `json:"place_of_supply,omitempty"`
+	LineItems       []LineItem           `json:"line_items"`
+	PaymentOptions  *PaymentOptions      `json:"payment_options,omitempty"`
+	Notes           string               `json:"notes,omitempty"`
+	Terms           string               `json:"terms,omitempty"`
+	CustomFields    []CustomFieldRequest `json:"custom_fields,omitempty"`
+}
+
+// RetainerInvoice is a retainer invoice as returned by the Books API
+type RetainerInvoice struct {
+	RetainerinvoiceID     string         `json:"retainerinvoice_id"`
+	RetainerinvoiceNumber string         `json:"retainerinvoice_number"`
+	Date                  zoho.Date      `json:"date"`
+	Status                string         `json:"status"`
+	ReferenceNumber       string         `json:"reference_number"`
+	CustomerID            string         `json:"customer_id"`
+	CustomerName          string         `json:"customer_name"`
+	ContactPersons        []string       `json:"contact_persons"`
+	CurrencyID            string         `json:"currency_id"`
+	CurrencyCode          string         `json:"currency_code"`
+	ExchangeRate          float64        `json:"exchange_rate"`
+	ProjectID             string         `json:"project_id"`
+	LineItems             []LineItem     `json:"line_items"`
+	SubTotal              zoho.Money     `json:"sub_total"`
+	Total                 zoho.Money     `json:"total"`
+	PaymentMade           zoho.Money     `json:"payment_made"`
+	Balance               zoho.Money     `json:"balance"`
+	PaymentOptions        PaymentOptions `json:"payment_options"`
+	PaymentDrawn          []struct {
+		InvoiceID     string     `json:"invoice_id"`
+		InvoiceNumber string     `json:"invoice_number"`
+		Date          zoho.Date  `json:"date"`
+		AmountApplied zoho.Money `json:"amount_applied"`
+	} `json:"payment_drawn_details"`
+	BillingAddress   Address       `json:"billing_address"`
+	ShippingAddress  Address       `json:"shipping_address"`
+	Notes            string        `json:"notes"`
+	Terms            string        `json:"terms"`
+	CustomFields     []CustomField `json:"custom_fields"`
+	TemplateID       string        `json:"template_id"`
+	TemplateName     string        `json:"template_name"`
+	CreatedTime      zoho.Time     `json:"created_time"`
+	LastModifiedTime zoho.Time     `json:"last_modified_time"`
+}
+
+// RetainerInvoiceResponse is the data returned by CreateRetainerInvoice, GetRetainerInvoice and UpdateRetainerInvoice
+type RetainerInvoiceResponse struct {
+	Code            int64           `json:"code"`
+	Message         string          `json:"message"`
+	RetainerInvoice RetainerInvoice `json:"retainerinvoice"`
+}
+
+// ListRetainerInvoicesResponse is the data returned by ListRetainerInvoices
+type ListRetainerInvoicesResponse struct {
+	Code             int64  `json:"code"`
+	Message          string `json:"message"`
+	RetainerInvoices []struct {
+		RetainerinvoiceID     string     `json:"retainerinvoice_id"`
+		RetainerinvoiceNumber string     `json:"retainerinvoice_number"`
+		CustomerID            string     `json:"customer_id"`
+		CustomerName          string     `json:"customer_name"`
+		Status                string     `json:"status"`
+		ReferenceNumber       string     `json:"reference_number"`
+		Date                  zoho.Date  `json:"date"`
+		CurrencyCode          string     `json:"currency_code"`
+		Total                 zoho.Money `json:"total"`
+		Balance               zoho.Money `json:"balance"`
+		CreatedTime           zoho.Time  `json:"created_time"`
+		LastModifiedTime      zoho.Time  `json:"last_modified_time"`
+	} `json:"retainerinvoices"`
+	PageContext PageContext `json:"page_context"`
+}
+
+// ApplyRetainerPaymentsRequest is the data provided to ApplyRetainerPaymentsToInvoice
+type ApplyRetainerPaymentsRequest struct {
+	InvoicePayments []RetainerPayment `json:"invoice_payments"`
+}
+
+// RetainerPayment is the amount of a retainer payment applied to an invoice
+type RetainerPayment struct {
+	PaymentID     string     `json:"payment_id"`
+	AmountApplied zoho.Money `json:"amount_applied"`
+}
+
+// ApplyRetainerPaymentsResponse is the data returned by ApplyRetainerPaymentsToInvoice
+type ApplyRetainerPaymentsResponse struct {
+	Code       int64  `json:"code"`
+	Message    string `json:"message"`
+	UseCredits struct {
+		InvoicePayments []struct {
+			InvoicePaymentID string     `json:"invoice_payment_id"`
+			PaymentID        string     `json:"payment_id"`
+			InvoiceID        string     `json:"invoice_id"`
+			AmountUsed       zoho.Money `json:"amount_used"`
+		} `json:"invoice_payments"`
+	} `json:"use_credits"`
+}
@@ -1 +1,397 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// SalesOrderStatus is a status that a sales order can be moved to
+type SalesOrderStatus string
+
+// Proper names for SalesOrder status transitions
+const (
+	SalesOrderStatusOpen SalesOrderStatus = "open"
+	SalesOrderStatusVoid SalesOrderStatus = "void"
+)
+
+// CreateSalesOrder will create a new sales order for a customer
+// https://www.zoho.com/books/api/v3/sales-order/#create-a-sales-order
+func (c *API) CreateSalesOrder(request SalesOrderRequest) (data SalesOrderResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         SalesOrdersModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, SalesOrdersModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &SalesOrderResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return SalesOrderResponse{}, fmt.Errorf("Failed to create sales order: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesOrderResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create sales order: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return SalesOrderResponse{}, fmt.Errorf("Data retrieved was not 'SalesOrderResponse'")
+}
+
+// GetSalesOrder will return the sales order specified by id
+// https://www.zoho.com/books/api/v3/sales-order/#get-a-sales-order
+func (c *API) GetSalesOrder(id string) (data SalesOrderResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: SalesOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			SalesOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &SalesOrderResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return SalesOrderResponse{}, fmt.Errorf("Failed to retrieve sales order (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesOrderResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve sales order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return SalesOrderResponse{}, fmt.Errorf("Data retrieved was not 'SalesOrderResponse'")
+}
+
+// ListSalesOrders will return the list of sales orders matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/sales-order/#list-sales-orders
+func (c *API) ListSalesOrders(
+	params map[string]zoho.Parameter,
+) (data ListSalesOrdersResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         SalesOrdersModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, SalesOrdersModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListSalesOrdersResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"salesorder_number": "",
+			"customer_id":       "",
+			"status":            "", // draft, open, invoiced, partially_invoiced, void, overdue
+			"filter_by":         "",
+			"search_text":       "",
+			"sort_column":       "",
+			"page":              "1",
+			"per_page":          "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListSalesOrdersResponse{}, fmt.Errorf("Failed to retrieve sales orders: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListSalesOrdersResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve sales orders: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListSalesOrdersResponse{}, fmt.Errorf("Data retrieved was not 'ListSalesOrdersResponse'")
+}
+
+// UpdateSalesOrder will modify the sales order specified by id
+// https://www.zoho.com/books/api/v3/sales-order/#update-a-sales-order
+func (c *API) UpdateSalesOrder(
+	id string,
+	request SalesOrderRequest,
+) (data SalesOrderResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: SalesOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			SalesOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &SalesOrderResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return SalesOrderResponse{}, fmt.Errorf("Failed to update sales order (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*SalesOrderResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update sales order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return SalesOrderResponse{}, fmt.Errorf("Data retrieved was not 'SalesOrderResponse'")
+}
+
+// DeleteSalesOrder will delete the sales order specified by id
+// https://www.zoho.com/books/api/v3/sales-order/#delete-a-sales-order
+func (c *API) DeleteSalesOrder(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: SalesOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			SalesOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete sales order (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete sales order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// MarkSalesOrderAs will move the sales order specified by id to the given status
+// https://www.zoho.com/books/api/v3/sales-order/#mark-as-open
+// https://www.zoho.com/books/api/v3/sales-order/#mark-as-void
+func (c *API) MarkSalesOrderAs(
+	id string,
+	status SalesOrderStatus,
+) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: SalesOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/status/%s",
+			c.ZohoTLD,
+			SalesOrdersModule,
+			id,
+			status,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to mark sales order (%s) as %s: %s",
+			id,
+			status,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to mark sales order (%s) as %s: %s",
+				id,
+				status,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// EmailSalesOrder will send the sales order specified by id to the provided recipients
+// https://www.zoho.com/books/api/v3/sales-order/#email-a-sales-order
+func (c *API) EmailSalesOrder(id string, request EmailRequest) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: SalesOrdersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/email",
+			c.ZohoTLD,
+			SalesOrdersModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to email sales order (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to email sales order (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// SalesOrderRequest is the data provided to CreateSalesOrder and UpdateSalesOrder
+type SalesOrderRequest struct {
+	CustomerID            string               `json:"customer_id"`
+	CurrencyID            string               `json:"currency_id,omitempty"`
+	ContactPersons        []string             `json:"contact_persons,omitempty"`
+	Date                  *zoho.Date           `json:"date,omitempty"`
+	ShipmentDate          *zoho.Date           `json:"shipment_date,omitempty"`
+	SalesorderNumber      string               `json:"salesorder_number,omitempty"`
+	ReferenceNumber       string               `json:"reference_number,omitempty"`
+	PlaceOfSupply         string               `json:"place_of_supply,omitempty"`
+	GstTreatment          string               `json:"gst_treatment,omitempty"`
+	GstNo                 string               `json:"gst_no,omitempty"`
+	LineItems             []LineItem           `json:"line_items"`
+	Notes                 string               `json:"notes,omitempty"`
+	Terms                 string               `json:"terms,omitempty"`
+	Discount              *float64             `json:"discount,omitempty"`
+	IsDiscountBeforeTax   bool                 `json:"is_discount_before_tax,omitempty"`
+	DiscountType          string               `json:"discount_type,omitempty"`
+	ShippingCharge        *zoho.Money          `json:"shipping_charge,omitempty"`
+	DeliveryMethod        string               `json:"delivery_method,omitempty"`
+	Adjustment            *zoho.Money          `json:"adjustment,omitempty"`
+	AdjustmentDescription string               `json:"adjustment_description,omitempty"`
+	PricebookID           string               `json:"pricebook_id,omitempty"`
+	SalespersonID         string               `json:"salesperson_id,omitempty"`
+	SalespersonName       string               `json:"salesperson_name,omitempty"`
+	ExchangeRate          float64              `json:"exchange_rate,omitempty"`
+	IsInclusiveTax        bool                 `json:"is_inclusive_tax,omitempty"`
+	TemplateID            string               `json:"template_id,omitempty"`
+	CustomFields          []CustomFieldRequest `json:"custom_fields,omitempty"`
+	BillingAddressID      string               `json:"billing_address_id,omitempty"`
+	ShippingAddressID     string               `json:"shipping_address_id,omitempty"`
+	EstimateID            string               `json:"estimate_id,omitempty"`
+}
+
+// SalesOrder is a sales order as returned by the Books API
+type SalesOrder struct {
+	SalesorderID          string       `json:"salesorder_id"`
+	SalesorderNumber      string       `json:"salesorder_number"`
+	Date                  zoho.Date    `json:"date"`
+	Status                string       `json:"status"`
+	ShipmentDate          zoho.Date    `json:"shipment_date"`
+	ReferenceNumber       string       `json:"reference_number"`
+	CustomerID            string       `json:"customer_id"`
+	CustomerName          string       `json:"customer_name"`
+	ContactPersons        []string     `json:"contact_persons"`
+	CurrencyID            string       `json:"currency_id"`
+	CurrencyCode          string       `json:"currency_code"`
+	ExchangeRate          float64      `json:"exchange_rate"`
+	Discount              float64      `json:"discount"`
+	IsDiscountBeforeTax   bool         `json:"is_discount_before_tax"`
+	DiscountType          string       `json:"discount_type"`
+	EstimateID            string       `json:"estimate_id"`
+	DeliveryMethod        string       `json:"delivery_method"`
+	IsInclusiveTax        bool         `json:"is_inclusive_tax"`
+	LineItems             []LineItem   `json:"line_items"`
+	ShippingCharge        zoho.Money   `json:"shipping_charge"`
+	Adjustment            zoho.Money   `json:"adjustment"`
+	AdjustmentDescription string       `json:"adjustment_description"`
+	SubTotal              zoho.Money   `json:"sub_total"`
+	TaxTotal              zoho.Money   `json:"tax_total"`
+	Total                 zoho.Money   `json:"total"`
+	Taxes                 []TaxSummary `json:"taxes"`
+	PricePrecision        int64        `json:"price_precision"`
+	Invoices              []struct {
+		InvoiceID     string     `json:"invoice_id"`
+		InvoiceNumber string     `json:"invoice_number"`
+		Status        string     `json:"status"`
+		Date          zoho.Date  `json:"date"`
+		Total         zoho.Money `json:"total"`
+		Balance       zoho.Money `json:"balance"`
+	} `json:"invoices"`
+	BillingAddress   Address       `json:"billing_address"`
+	ShippingAddress  Address       `json:"shipping_address"`
+	Notes            string        `json:"notes"`
+	Terms            string        `json:"terms"`
+	CustomFields     []CustomField `json:"custom_fields"`
+	TemplateID       string        `json:"template_id"`
+	TemplateName     string        `json:"template_name"`
+	SalespersonID    string        `json:"salesperson_id"`
+	SalespersonName  string        `json:"salesperson_name"`
+	CreatedTime      zoho.Time     `json:"created_time"`
+	LastModifiedTime zoho.Time     `json:"last_modified_time"`
+}
+
+// SalesOrderResponse is the data returned by CreateSalesOrder, GetSalesOrder and UpdateSalesOrder
+type SalesOrderResponse struct {
+	Code       int64      `json:"code"`
+	Message    string     `json:"message"`
+	SalesOrder SalesOrder `json:"salesorder"`
+}
+
+// ListSalesOrdersResponse is the data returned by ListSalesOrders
+type ListSalesOrdersResponse struct {
+	Code        int64  `json:"code"`
+	Message     string `json:"message"`
+	SalesOrders []struct {
+		SalesorderID     string     `json:"salesorder_id"`
+		CustomerName     string     `json:"customer_name"`
+		CustomerID       string     `json:"customer_id"`
+		Status           string     `json:"status"`
+		SalesorderNumber string     `json:"salesorder_number"`
+		ReferenceNumber  string     `json:"reference_number"`
+		Date             zoho.Date  `json:"date"`
+		ShipmentDate     zoho.Date  `json:"shipment_date"`
+		CurrencyID       string     `json:"currency_id"`
+		CurrencyCode     string     `json:"currency_code"`
+		Total            zoho.Money `json:"total"`
+		BcyTotal         zoho.Money `json:"bcy_total"`
+		IsEmailed        bool       `json:"is_emailed"`
+		CreatedTime      zoho.Time  `json:"created_time"`
+		LastModifiedTime zoho.Time  `json:"last_modified_time"`
+		SalespersonName  string     `json:"salesperson_name"`
+	} `json:"salesorders"`
+	PageContext PageContext `json:"page_context"`
+}
@@ -0,0 +1,637 @@
+package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// GetPreferences will return the general preferences of the organization
+func (c *API) GetPreferences() (data PreferencesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         PreferencesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, PreferencesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &PreferencesResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PreferencesResponse{}, fmt.Errorf("Failed to retrieve preferences: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PreferencesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve preferences: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return PreferencesResponse{}, fmt.Errorf("Data retrieved was not 'PreferencesResponse'")
+}
+
+// UpdatePreferences will modify the general preferences of the organization
+func (c *API) UpdatePreferences(request PreferencesRequest) (data PreferencesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         PreferencesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, PreferencesModule),
+		Method:       zoho.HTTPPut,
+		ResponseData: &PreferencesResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PreferencesResponse{}, fmt.Errorf("Failed to update preferences: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PreferencesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update preferences: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return PreferencesResponse{}, fmt.Errorf("Data retrieved was not 'PreferencesResponse'")
+}
+
+// GetInvoiceSettings will return the invoice numbering and default settings of the organization
+func (c *API) GetInvoiceSettings() (data InvoiceSettingsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         InvoiceSettingsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, InvoiceSettingsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &InvoiceSettingsResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return InvoiceSettingsResponse{}, fmt.Errorf("Failed to retrieve invoice settings: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceSettingsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve invoice settings: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return InvoiceSettingsResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceSettingsResponse'")
+}
+
+// UpdateInvoiceSettings will modify the invoice numbering and default settings of the organization
+func (c *API) UpdateInvoiceSettings(
+	request InvoiceSettingsRequest,
+) (data InvoiceSettingsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         InvoiceSettingsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, InvoiceSettingsModule),
+		Method:       zoho.HTTPPut,
+		ResponseData: &InvoiceSettingsResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return InvoiceSettingsResponse{}, fmt.Errorf("Failed to update invoice settings: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*InvoiceSettingsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update invoice settings: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return InvoiceSettingsResponse{}, fmt.Errorf("Data retrieved was not 'InvoiceSettingsResponse'")
+}
+
+// GetEstimateSettings will return the estimate numbering and default settings of the organization
+func (c *API) GetEstimateSettings() (data EstimateSettingsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         EstimateSettingsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, EstimateSettingsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &EstimateSettingsResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return EstimateSettingsResponse{}, fmt.Errorf(
+			"Failed to retrieve estimate settings: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateSettingsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve estimate settings: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return EstimateSettingsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'EstimateSettingsResponse'",
+	)
+}
+
+// UpdateEstimateSettings will modify the estimate numbering and default settings of the organization
+func (c *API) UpdateEstimateSettings(
+	request EstimateSettingsRequest,
+) (data EstimateSettingsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         EstimateSettingsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, EstimateSettingsModule),
+		Method:       zoho.HTTPPut,
+		ResponseData: &EstimateSettingsResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return EstimateSettingsResponse{}, fmt.Errorf("Failed to update estimate settings: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*EstimateSettingsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update estimate settings: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return EstimateSettingsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'EstimateSettingsResponse'",
+	)
+}
+
+// ListInvoiceTemplates will return the PDF templates available for invoices
+// https://www.zoho.com/books/api/v3/invoices/#list-invoice-templates
+func (c *API) ListInvoiceTemplates() (data ListTemplatesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         InvoicesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s/templates", c.ZohoTLD, InvoicesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListTemplatesResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListTemplatesResponse{}, fmt.Errorf("Failed to retrieve invoice templates: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListTemplatesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve invoice templates: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListTemplatesResponse{}, fmt.Errorf("Data retrieved was not 'ListTemplatesResponse'")
+}
+
+// ListEstimateTemplates will return the PDF templates available for estimates
+// https://www.zoho.com/books/api/v3/estimates/#list-estimate-template
+func (c *API) ListEstimateTemplates() (data ListTemplatesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         EstimatesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s/templates", c.ZohoTLD, EstimatesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListTemplatesResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListTemplatesResponse{}, fmt.Errorf("Failed to retrieve estimate templates: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListTemplatesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve estimate templates: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListTemplatesResponse{}, fmt.Errorf("Data retrieved was not 'ListTemplatesResponse'")
+}
+
+// ListPaymentTerms will return the payment terms configured for the organization
+func (c *API) ListPaymentTerms() (data ListPaymentTermsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         PaymentTermsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, PaymentTermsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListPaymentTermsResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListPaymentTermsResponse{}, fmt.Errorf("Failed to retrieve payment terms: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListPaymentTermsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve payment terms: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListPaymentTermsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListPaymentTermsResponse'",
+	)
+}
+
+// CreatePaymentTerm will add a payment term to the organization
+func (c *API) CreatePaymentTerm(request PaymentTermRequest) (data PaymentTermResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         PaymentTermsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, PaymentTermsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &PaymentTermResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PaymentTermResponse{}, fmt.Errorf("Failed to create payment term: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PaymentTermResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create payment term: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return PaymentTermResponse{}, fmt.Errorf("Data retrieved was not 'PaymentTermResponse'")
+}
+
+// UpdatePaymentTerm will modify the payment term specified by id
+func (c *API) UpdatePaymentTerm(
+	id string,
+	request PaymentTermRequest,
+) (data PaymentTermResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: PaymentTermsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			PaymentTermsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &PaymentTermResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PaymentTermResponse{}, fmt.Errorf("Failed to update payment term (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PaymentTermResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update payment term (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return PaymentTermResponse{}, fmt.Errorf("Data retrieved was not 'PaymentTermResponse'")
+}
+
+// DeletePaymentTerm will remove the payment term specified by id
+func (c *API) DeletePaymentTerm(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: PaymentTermsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			PaymentTermsModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete payment term (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete payment term (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ListCustomFields will return the custom fields configured for the entity (invoice, estimate, contact,
+// item, etc.) including their data types and options
+func (c *API) ListCustomFields(entity string) (data ListCustomFieldsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         CustomFieldsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, CustomFieldsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListCustomFieldsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"entity": zoho.Parameter(entity),
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListCustomFieldsResponse{}, fmt.Errorf(
+			"Failed to retrieve custom fields (%s): %s",
+			entity,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListCustomFieldsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve custom fields (%s): %s", entity, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListCustomFieldsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListCustomFieldsResponse'",
+	)
+}
+
+// PreferencesRequest is the data provided to UpdatePreferences
+type PreferencesRequest struct {
+	ConvertToInvoice                        *bool  `json:"convert_to_invoice,omitempty"`
+	AttachPdfForEmail                       string `json:"attach_pdf_for_email,omitempty"`
+	EstimateApprovalStatus                  string `json:"estimate_approval_status,omitempty"`
+	NotifyMeOnOnlinePayment                 *bool  `json:"notify_me_on_online_payment,omitempty"`
+	SendPaymentReceiptAcknowledgement       string `json:"send_payment_receipt_acknowledgement,omitempty"`
+	AutoNotifyRecurringInvoice              string `json:"auto_notify_recurring_invoice,omitempty"`
+	SnailMailIncludePaymentStub             string `json:"snail_mail_include_payment_stub,omitempty"`
+	IsShowPoweredBy                         *bool  `json:"is_show_powered_by,omitempty"`
+	AttachExpenseReceiptToInvoice           *bool  `json:"attach_expense_receipt_to_invoice,omitempty"`
+	IsEstimateEnabled                       *bool  `json:"is_estimate_enabled,omitempty"`
+	IsProjectEnabled                        *bool  `json:"is_project_enabled,omitempty"`
+	IsPurchaseorderEnabled                  *bool  `json:"is_purchaseorder_enabled,omitempty"`
+	IsSalesorderEnabled                     *bool  `json:"is_salesorder_enabled,omitempty"`
+	IsPricebooksEnabled                     *bool  `json:"is_pricebooks_enabled,omitempty"`
+	AttachPaymentReceiptWithAcknowledgement string `json:"attach_payment_receipt_with_acknowledgement,omitempty"`
+}
+
+// Preferences are the general settings of the organization
+type Preferences struct {
+	ConvertToInvoice                        bool   `json:"convert_to_invoice"`
+	AttachPdfForEmail                       string `json:"attach_pdf_for_email"`
+	EstimateApprovalStatus                  string `json:"estimate_approval_status"`
+	NotifyMeOnOnlinePayment                 bool   `json:"notify_me_on_online_payment"`
+	SendPaymentReceiptAcknowledgement       string `json:"send_payment_receipt_acknowledgement"`
+	AutoNotifyRecurringInvoice              string `json:"auto_notify_recurring_invoice"`
+	SnailMailIncludePaymentStub             string `json:"snail_mail_include_payment_stub"`
+	IsShowPoweredBy                         bool   `json:"is_show_powered_by"`
+	AttachExpenseReceiptToInvoice           bool   `json:"attach_expense_receipt_to_invoice"`
+	IsEstimateEnabled                       bool   `json:"is_estimate_enabled"`
+	IsProjectEnabled                        bool   `json:"is_project_enabled"`
+	IsPurchaseorderEnabled                  bool   `json:"is_purchaseorder_enabled"`
+	IsSalesorderEnabled                     bool   `json:"is_salesorder_enabled"`
+	IsPricebooksEnabled                     bool   `json:"is_pricebooks_enabled"`
+	AttachPaymentReceiptWithAcknowledgement string `json:"attach_payment_receipt_with_acknowledgement"`
+	AutoReminders                           []struct {
+		AutoreminderID string `json:"autoreminder_id"`
+		IsEnabled      bool   `json:"is_enabled"`
+		Notification   string `json:"notification"`
+		AddressType    string `json:"address_type"`
+		Subject        string `json:"subject"`
+		Body           string `json:"body"`
+	} `json:"auto_reminders"`
+	Terms struct {
+		InvoiceTerms    string `json:"invoice_terms"`
+		EstimateTerms   string `json:"estimate_terms"`
+		CreditnoteTerms string `json:"creditnote_terms"`
+		CustomerNotes   string `json:"customer_notes"`
+	} `json:"terms"`
+	AddressFormats struct {
+		OrganizationAddressFormat string `json:"organization_address_format"`
+		CustomerAddressFormat     string `json:"customer_address_format"`
+	} `json:"address_formats"`
+}
+
+// PreferencesResponse is the data returned by GetPreferences and UpdatePreferences
+type PreferencesResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Preferences Preferences `json:"preferences"`
+}
+
+// InvoiceSettingsRequest is the data provided to UpdateInvoiceSettings
+type InvoiceSettingsRequest struct {
+	AutoGenerate             *bool  `json:"auto_generate,omitempty"`
+	PrefixString             string `json:"prefix_string,omitempty"`
+	StartAt                  int64  `json:"start_at,omitempty"`
+	NextNumber               string `json:"next_number,omitempty"`
+	QuantityPrecision        int64  `json:"quantity_precision,omitempty"`
+	DiscountType             string `json:"discount_type,omitempty"` // no_discount, item_level, entity_level
+	ReferenceText            string `json:"reference_text,omitempty"`
+	DefaultTemplateID        string `json:"default_template_id,omitempty"`
+	Notes                    string `json:"notes,omitempty"`
+	Terms                    string `json:"terms,omitempty"`
+	IsShippingChargeRequired *bool  `json:"is_shipping_charge_required,omitempty"`
+	IsAdjustmentRequired     *bool  `json:"is_adjustment_required,omitempty"`
+	IsOpenInvoiceEditable    *bool  `json:"is_open_invoice_editable,omitempty"`
+	WarnConvertToOpen        *bool  `json:"warn_convert_to_open,omitempty"`
+	WarnCreateCreditnotes    *bool  `json:"warn_create_creditnotes,omitempty"`
+	InvoiceItemType          string `json:"invoice_item_type,omitempty"`
+	IsSalesPersonRequired    *bool  `json:"is_sales_person_required,omitempty"`
+}
+
+// InvoiceSettings are the numbering and default values applied to new invoices
+type InvoiceSettings struct {
+	AutoGenerate                  bool   `json:"auto_generate"`
+	PrefixString                  string `json:"prefix_string"`
+	StartAt                       int64  `json:"start_at"`
+	NextNumber                    string `json:"next_number"`
+	QuantityPrecision             int64  `json:"quantity_precision"`
+	DiscountType                  string `json:"discount_type"`
+	ReferenceText                 string `json:"reference_text"`
+	DefaultTemplateID             string `json:"default_template_id"`
+	Notes                         string `json:"notes"`
+	Terms                         string `json:"terms"`
+	IsShippingChargeRequired      bool   `json:"is_shipping_charge_required"`
+	IsAdjustmentRequired          bool   `json:"is_adjustment_required"`
+	IsOpenInvoiceEditable         bool   `json:"is_open_invoice_editable"`
+	WarnConvertToOpen             bool   `json:"warn_convert_to_open"`
+	WarnCreateCreditnotes         bool   `json:"warn_create_creditnotes"`
+	AttachExpenseReceiptToInvoice string `json:"attach_expense_receipt_to_invoice"`
+	InvoiceItemType               string `json:"invoice_item_type"`
+	IsSalesPersonRequired         bool   `json:"is_sales_person_required"`
+}
+
+// InvoiceSettingsResponse is the data returned by GetInvoiceSettings and UpdateInvoiceSettings
+type InvoiceSettingsResponse struct {
+	Code            int64           `json:"code"`
+	Message         string          `json:"message"`
+	InvoiceSettings InvoiceSettings `json:"invoice_settings"`
+}
+
+// EstimateSettingsRequest is the data provided to UpdateEstimateSettings
+type EstimateSettingsRequest struct {
+	AutoGenerate          *bool  `json:"auto_generate,omitempty"`
+	PrefixString          string `json:"prefix_string,omitempty"`
+	StartAt               int64  `json:"start_at,omitempty"`
+	NextNumber            string `json:"next_number,omitempty"`
+	QuantityPrecision     int64  `json:"quantity_precision,omitempty"`
+	DiscountType          string `json:"discount_type,omitempty"` // no_discount, item_level, entity_level
+	ReferenceText         string `json:"reference_text,omitempty"`
+	DefaultTemplateID     string `json:"default_template_id,omitempty"`
+	Notes                 string `json:"notes,omitempty"`
+	Terms                 string `json:"terms,omitempty"`
+	TermsToInvoice        *bool  `json:"terms_to_invoice,omitempty"`
+	NotesToInvoice        *bool  `json:"notes_to_invoice,omitempty"`
+	WarnEstimateToInvoice *bool  `json:"warn_estimate_to_invoice,omitempty"`
+	IsSalesPersonRequired *bool  `json:"is_sales_person_required,omitempty"`
+}
+
+// EstimateSettings are the numbering and default values applied to new estimates
+type EstimateSettings struct {
+	AutoGenerate          bool   `json:"auto_generate"`
+	PrefixString          string `json:"prefix_string"`
+	StartAt               int64  `json:"start_at"`
+	NextNumber            string `json:"next_number"`
+	QuantityPrecision     int64  `json:"quantity_precision"`
+	DiscountType          string `json:"discount_type"`
+	ReferenceText         string `json:"reference_text"`
+	DefaultTemplateID     string `json:"default_template_id"`
+	Notes                 string `json:"notes"`
+	Terms                 string `json:"terms"`
+	TermsToInvoice        bool   `json:"terms_to_invoice"`
+	NotesToInvoice        bool   `json:"notes_to_invoice"`
+	WarnEstimateToInvoice bool   `json:"warn_estimate_to_invoice"`
+	IsSalesPersonRequired bool   `json:"is_sales_person_required"`
+}
+
+// EstimateSettingsResponse is the data returned by GetEstimateSettings and UpdateEstimateSettings
+type EstimateSettingsResponse struct {
+	Code             int64            `json:"code"`
+	Message          string           `json:"message"`
+	EstimateSettings EstimateSettings `json:"estimate_settings"`
+}
+
+// Template is a PDF template which can be used to render a document
+type Template struct {
+	TemplateID   string `json:"template_id"`
+	TemplateName string `json:"template_name"`
+	TemplateType string `json:"template_type"`
+}
+
+// ListTemplatesResponse is the data returned by ListInvoiceTemplates and ListEstimateTemplates
+type ListTemplatesResponse struct {
+	Code      int64      `json:"code"`
+	Message   string     `json:"message"`
+	Templates []Template `json:"templates"`
+}
+
+// PaymentTermRequest is the data provided to CreatePaymentTerm and UpdatePaymentTerm
+type PaymentTermRequest struct {
+	PaymentTerms      int64  `json:"payment_terms"`
+	PaymentTermsLabel string `json:"payment_terms_label"`
+	IsDefault         bool   `json:"is_default,omitempty"`
+}
+
+// PaymentTerm is the number of days after which a document is due
+type PaymentTerm struct {
+	PaymentTermsID    string `json:"payment_terms_id"`
+	PaymentTerms      int64  `json:"payment_terms"`
+	PaymentTermsLabel string `json:"payment_terms_label"`
+	IsDefault         bool   `json:"is_default"`
+}
+
+// PaymentTermResponse is the data returned by CreatePaymentTerm and UpdatePaymentTerm
+type PaymentTermResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	PaymentTerm PaymentTerm `json:"payment_term"`
+}
+
+// ListPaymentTermsResponse is the data returned by ListPaymentTerms
+type ListPaymentTermsResponse struct {
+	Code         int64         `json:"code"`
+	Message      string        `json:"message"`
+	PaymentTerms []PaymentTerm `json:"payment_terms"`
+}
+
+// CustomFieldMetadata describes a custom field configured for an entity
+type CustomFieldMetadata struct {
+	CustomfieldID      string   `json:"customfield_id"`
+	Entity             string   `json:"entity"`
+	Label              string   `json:"label"`
+	FieldNameFormatted string   `json:"field_name_formatted"`
+	PlaceholderText    string   `json:"placeholder"`
+	DataType           string   `json:"data_type"`
+	DefaultValue       string   `json:"default_value"`
+	Values             []string `json:"values"`
+	IsActive           bool     `json:"is_active"`
+	IsMandatory        bool     `json:"is_mandatory"`
+	ShowOnPdf          bool     `json:"show_on_pdf"`
+	ShowInAllPdf       bool     `json:"show_in_all_pdf"`
+	Index              int64    `json:"index"`
+}
+
+// ListCustomFieldsResponse is the data returned by ListCustomFields
+type ListCustomFieldsResponse struct {
+	Code         int64                 `json:"code"`
+	Message      string                `json:"message"`
+	CustomFields []CustomFieldMetadata `json:"customfields"`
+}
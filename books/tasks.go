@@ -1 +1,284 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateTask will add a new task to the project specified by projectID
+// https://www.zoho.com/books/api/v3/tasks/#add-a-task
+func (c *API) CreateTask(projectID string, request TaskRequest) (data TaskResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/tasks",
+			c.ZohoTLD,
+			ProjectsModule,
+			projectID,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TaskResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf(
+			"Failed to create task for project (%s): %s",
+			projectID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaskResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to create task for project (%s): %s",
+				projectID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return TaskResponse{}, fmt.Errorf("Data retrieved was not 'TaskResponse'")
+}
+
+// GetTask will return the task specified by id of the project specified by projectID
+// https://www.zoho.com/books/api/v3/tasks/#get-a-task
+func (c *API) GetTask(projectID string, id string) (data TaskResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/tasks/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			projectID,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TaskResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf(
+			"Failed to retrieve task (%s) of project (%s): %s",
+			id,
+			projectID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaskResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve task (%s) of project (%s): %s",
+				id,
+				projectID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return TaskResponse{}, fmt.Errorf("Data retrieved was not 'TaskResponse'")
+}
+
+// ListTasks will return the tasks of the project specified by projectID
+// https://www.zoho.com/books/api/v3/tasks/#list-tasks
+func (c *API) ListTasks(
+	projectID string,
+	params map[string]zoho.Parameter,
+) (data ListTasksResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/tasks",
+			c.ZohoTLD,
+			ProjectsModule,
+			projectID,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListTasksResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"sort_column": "", // task_name, billed_hours, log_time, un_billed_hours
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListTasksResponse{}, fmt.Errorf(
+			"Failed to retrieve tasks of project (%s): %s",
+			projectID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListTasksResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve tasks of project (%s): %s",
+				projectID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ListTasksResponse{}, fmt.Errorf("Data retrieved was not 'ListTasksResponse'")
+}
+
+// UpdateTask will modify the task specified by id of the project specified by projectID
+// https://www.zoho.com/books/api/v3/tasks/#update-a-task
+func (c *API) UpdateTask(
+	projectID string,
+	id string,
+	request TaskRequest,
+) (data TaskResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/tasks/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			projectID,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TaskResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaskResponse{}, fmt.Errorf(
+			"Failed to update task (%s) of project (%s): %s",
+			id,
+			projectID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaskResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to update task (%s) of project (%s): %s",
+				id,
+				projectID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return TaskResponse{}, fmt.Errorf("Data retrieved was not 'TaskResponse'")
+}
+
+// DeleteTask will delete the task specified by id of the project specified by projectID
+// https://www.zoho.com/books/api/v3/tasks/#delete-task
+func (c *API) DeleteTask(projectID string, id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: ProjectsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/tasks/%s",
+			c.ZohoTLD,
+			ProjectsModule,
+			projectID,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf(
+			"Failed to delete task (%s) of project (%s): %s",
+			id,
+			projectID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to delete task (%s) of project (%s): %s",
+				id,
+				projectID,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// TaskRequest is the data provided to CreateTask and UpdateTask
+type TaskRequest struct {
+	TaskName    string `json:"task_name"`
+	Description string `json:"description,omitempty"`
+	// Rate is the hourly rate of the task when the project is billed on task hours
+	Rate        *zoho.Money `json:"rate,omitempty"`
+	BudgetHours string      `json:"budget_hours,omitempty"`
+}
+
+// Task is a task of a project as returned by the Books API
+type Task struct {
+	TaskID        string     `json:"task_id"`
+	ProjectID     string     `json:"project_id"`
+	ProjectName   string     `json:"project_name"`
+	TaskName      string     `json:"task_name"`
+	Description   string     `json:"description"`
+	Rate          zoho.Money `json:"rate"`
+	BudgetHours   string     `json:"budget_hours"`
+	TotalHours    string     `json:"total_hours"`
+	BilledHours   string     `json:"billed_hours"`
+	UnBilledHours string     `json:"un_billed_hours"`
+	Status        string     `json:"status"`
+	IsBillable    bool       `json:"is_billable"`
+}
+
+// TaskResponse is the data returned by CreateTask, GetTask and UpdateTask
+type TaskResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+	Task    Task   `json:"task"`
+}
+
+// ListTasksResponse is the data returned by ListTasks
+type ListTasksResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Task        []Task      `json:"task"`
+	PageContext PageContext `json:"page_context"`
+}
@@ -1 +1,794 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateTax will create a new tax
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax
+func (c *API) CreateTax(request TaxRequest) (data TaxResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TaxesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TaxesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TaxResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxResponse{}, fmt.Errorf("Failed to create tax: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create tax: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxResponse{}, fmt.Errorf("Data retrieved was not 'TaxResponse'")
+}
+
+// GetTax will return the tax specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax
+func (c *API) GetTax(id string) (data TaxResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TaxResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxResponse{}, fmt.Errorf("Failed to retrieve tax (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve tax (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxResponse{}, fmt.Errorf("Data retrieved was not 'TaxResponse'")
+}
+
+// ListTaxes will return the list of taxes
+// https://www.zoho.com/books/api/v3/taxes/#list-taxes
+func (c *API) ListTaxes() (data ListTaxesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TaxesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TaxesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListTaxesResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListTaxesResponse{}, fmt.Errorf("Failed to retrieve taxes: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListTaxesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve taxes: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListTaxesResponse{}, fmt.Errorf("Data retrieved was not 'ListTaxesResponse'")
+}
+
+// UpdateTax will modify the tax specified by id
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax
+func (c *API) UpdateTax(id string, request TaxRequest) (data TaxResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TaxResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxResponse{}, fmt.Errorf("Failed to update tax (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update tax (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxResponse{}, fmt.Errorf("Data retrieved was not 'TaxResponse'")
+}
+
+// DeleteTax will delete the tax specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax
+func (c *API) DeleteTax(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete tax (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete tax (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// CreateTaxGroup will create a new tax group
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax-group
+func (c *API) CreateTaxGroup(request TaxGroupRequest) (data TaxGroupResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TaxGroupsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TaxGroupsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TaxGroupResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxGroupResponse{}, fmt.Errorf("Failed to create tax group: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxGroupResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create tax group: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxGroupResponse{}, fmt.Errorf("Data retrieved was not 'TaxGroupResponse'")
+}
+
+// GetTaxGroup will return the tax group specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax-group
+func (c *API) GetTaxGroup(id string) (data TaxGroupResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxGroupsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxGroupsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TaxGroupResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxGroupResponse{}, fmt.Errorf("Failed to retrieve tax group (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxGroupResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve tax group (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxGroupResponse{}, fmt.Errorf("Data retrieved was not 'TaxGroupResponse'")
+}
+
+// UpdateTaxGroup will modify the tax group specified by id
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax-group
+func (c *API) UpdateTaxGroup(
+	id string,
+	request TaxGroupRequest,
+) (data TaxGroupResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxGroupsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxGroupsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TaxGroupResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxGroupResponse{}, fmt.Errorf("Failed to update tax group (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxGroupResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update tax group (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxGroupResponse{}, fmt.Errorf("Data retrieved was not 'TaxGroupResponse'")
+}
+
+// DeleteTaxGroup will delete the tax group specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax-group
+func (c *API) DeleteTaxGroup(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxGroupsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxGroupsModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete tax group (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete tax group (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// CreateTaxAuthority will create a new tax authority
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax-authority-us-and-ca-edition-only
+func (c *API) CreateTaxAuthority(
+	request TaxAuthorityRequest,
+) (data TaxAuthorityResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TaxAuthoritiesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TaxAuthoritiesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TaxAuthorityResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxAuthorityResponse{}, fmt.Errorf("Failed to create tax authority: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxAuthorityResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create tax authority: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxAuthorityResponse{}, fmt.Errorf("Data retrieved was not 'TaxAuthorityResponse'")
+}
+
+// GetTaxAuthority will return the tax authority specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax-authority-us-and-ca-edition-only
+func (c *API) GetTaxAuthority(id string) (data TaxAuthorityResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxAuthoritiesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxAuthoritiesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TaxAuthorityResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxAuthorityResponse{}, fmt.Errorf(
+			"Failed to retrieve tax authority (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxAuthorityResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve tax authority (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxAuthorityResponse{}, fmt.Errorf("Data retrieved was not 'TaxAuthorityResponse'")
+}
+
+// ListTaxAuthorities will return the list of tax authorities
+// https://www.zoho.com/books/api/v3/taxes/#list-tax-authorities-us-edition-only
+func (c *API) ListTaxAuthorities() (data ListTaxAuthoritiesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TaxAuthoritiesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TaxAuthoritiesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListTaxAuthoritiesResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListTaxAuthoritiesResponse{}, fmt.Errorf(
+			"Failed to retrieve tax authorities: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListTaxAuthoritiesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve tax authorities: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListTaxAuthoritiesResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListTaxAuthoritiesResponse'",
+	)
+}
+
+// UpdateTaxAuthority will modify the tax authority specified by id
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax-authority-us-and-ca-edition-only
+func (c *API) UpdateTaxAuthority(
+	id string,
+	request TaxAuthorityRequest,
+) (data TaxAuthorityResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxAuthoritiesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxAuthoritiesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TaxAuthorityResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxAuthorityResponse{}, fmt.Errorf(
+			"Failed to update tax authority (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxAuthorityResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update tax authority (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxAuthorityResponse{}, fmt.Errorf("Data retrieved was not 'TaxAuthorityResponse'")
+}
+
+// DeleteTaxAuthority will delete the tax authority specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax-authority-us-and-ca-edition-only
+func (c *API) DeleteTaxAuthority(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxAuthoritiesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxAuthoritiesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete tax authority (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete tax authority (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// CreateTaxExemption will create a new tax exemption
+// https://www.zoho.com/books/api/v3/taxes/#create-a-tax-exemption-us-edition-only
+func (c *API) CreateTaxExemption(
+	request TaxExemptionRequest,
+) (data TaxExemptionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TaxExemptionsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TaxExemptionsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TaxExemptionResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxExemptionResponse{}, fmt.Errorf("Failed to create tax exemption: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxExemptionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create tax exemption: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxExemptionResponse{}, fmt.Errorf("Data retrieved was not 'TaxExemptionResponse'")
+}
+
+// GetTaxExemption will return the tax exemption specified by id
+// https://www.zoho.com/books/api/v3/taxes/#get-a-tax-exemption-us-edition-only
+func (c *API) GetTaxExemption(id string) (data TaxExemptionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxExemptionsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxExemptionsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TaxExemptionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxExemptionResponse{}, fmt.Errorf(
+			"Failed to retrieve tax exemption (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxExemptionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve tax exemption (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxExemptionResponse{}, fmt.Errorf("Data retrieved was not 'TaxExemptionResponse'")
+}
+
+// ListTaxExemptions will return the list of tax exemptions
+// https://www.zoho.com/books/api/v3/taxes/#list-tax-exemptions-us-edition-only
+func (c *API) ListTaxExemptions() (data ListTaxExemptionsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TaxExemptionsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TaxExemptionsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListTaxExemptionsResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListTaxExemptionsResponse{}, fmt.Errorf("Failed to retrieve tax exemptions: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListTaxExemptionsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve tax exemptions: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListTaxExemptionsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListTaxExemptionsResponse'",
+	)
+}
+
+// UpdateTaxExemption will modify the tax exemption specified by id
+// https://www.zoho.com/books/api/v3/taxes/#update-a-tax-exemption-us-edition-only
+func (c *API) UpdateTaxExemption(
+	id string,
+	request TaxExemptionRequest,
+) (data TaxExemptionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxExemptionsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxExemptionsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TaxExemptionResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TaxExemptionResponse{}, fmt.Errorf(
+			"Failed to update tax exemption (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TaxExemptionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update tax exemption (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TaxExemptionResponse{}, fmt.Errorf("Data retrieved was not 'TaxExemptionResponse'")
+}
+
+// DeleteTaxExemption will delete the tax exemption specified by id
+// https://www.zoho.com/books/api/v3/taxes/#delete-a-tax-exemption-us-edition-only
+func (c *API) DeleteTaxExemption(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TaxExemptionsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TaxExemptionsModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete tax exemption (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete tax exemption (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// TaxRequest is the data provided to CreateTax and UpdateTax
+type TaxRequest struct {
+	TaxName                     string  `json:"tax_name"`
+	TaxPercentage               float64 `json:"tax_percentage"`
+	TaxType                     string  `json:"tax_type,omitempty"` // tax, compound_tax
+	TaxFactor                   string  `json:"tax_factor,omitempty"`
+	TaxSpecificType             string  `json:"tax_specific_type,omitempty"`
+	TaxAuthorityName            string  `json:"tax_authority_name,omitempty"`
+	TaxAuthorityID              string  `json:"tax_authority_id,omitempty"`
+	CountryCode                 string  `json:"country_code,omitempty"`
+	PurchaseTaxExpenseAccountID string  `json:"purchase_tax_expense_account_id,omitempty"`
+	IsValueAdded                bool    `json:"is_value_added,omitempty"`
+	UpdateRecurringInvoice      bool    `json:"update_recurring_invoice,omitempty"`
+	UpdateRecurringExpense      bool    `json:"update_recurring_expense,omitempty"`
+	UpdateDraftInvoice          bool    `json:"update_draft_invoice,omitempty"`
+	UpdateRecurringBills        bool    `json:"update_recurring_bills,omitempty"`
+	UpdateDraftSo               bool    `json:"update_draft_so,omitempty"`
+	UpdateSubscription          bool    `json:"update_subscription,omitempty"`
+	UpdateProject               bool    `json:"update_project,omitempty"`
+	IsEditable                  bool    `json:"is_editable,omitempty"`
+}
+
+// Tax is a tax as returned by the Books API
+type Tax struct {
+	TaxID            string  `json:"tax_id"`
+	TaxName          string  `json:"tax_name"`
+	TaxPercentage    float64 `json:"tax_percentage"`
+	TaxType          string  `json:"tax_type"`
+	TaxFactor        string  `json:"tax_factor"`
+	TaxSpecificType  string  `json:"tax_specific_type"`
+	TaxAuthorityID   string  `json:"tax_authority_id"`
+	TaxAuthorityName string  `json:"tax_authority_name"`
+	IsValueAdded     bool    `json:"is_value_added"`
+	IsDefaultTax     bool    `json:"is_default_tax"`
+	IsEditable       bool    `json:"is_editable"`
+	Status           string  `json:"status"`
+}
+
+// TaxResponse is the data returned by CreateTax, GetTax and UpdateTax
+type TaxResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+	Tax     Tax    `json:"tax"`
+}
+
+// ListTaxesResponse is the data returned by ListTaxes
+type ListTaxesResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Taxes       []Tax       `json:"taxes"`
+	PageContext PageContext `json:"page_context"`
+}
+
+// TaxGroupRequest is the data provided to CreateTaxGroup and UpdateTaxGroup
+type TaxGroupRequest struct {
+	TaxGroupName string `json:"tax_group_name"`
+	// Taxes is a comma separated list of the tax IDs grouped together
+	Taxes string `json:"taxes"`
+}
+
+// TaxGroupResponse is the data returned by CreateTaxGroup, GetTaxGroup and UpdateTaxGroup
+type TaxGroupResponse struct {
+	Code     int64  `json:"code"`
+	Message  string `json:"message"`
+	TaxGroup struct {
+		TaxGroupID         string  `json:"tax_group_id"`
+		TaxGroupName       string  `json:"tax_group_name"`
+		TaxGroupPercentage float64 `json:"tax_group_percentage"`
+		Taxes              []Tax   `json:"taxes"`
+	} `json:"tax_group"`
+}
+
+// TaxAuthorityRequest is the data provided to CreateTaxAuthority and UpdateTaxAuthority
+type TaxAuthorityRequest struct {
+	TaxAuthorityName        string `json:"tax_authority_name"`
+	Description             string `json:"description,omitempty"`
+	RegistrationNumberLabel string `json:"registration_number_label,omitempty"`
+	RegistrationNumber      string `json:"registration_number,omitempty"`
+}
+
+// TaxAuthority is an authority taxes are reported to
+type TaxAuthority struct {
+	TaxAuthorityID          string `json:"tax_authority_id"`
+	TaxAuthorityName        string `json:"tax_authority_name"`
+	Description             string `json:"description"`
+	RegistrationNumberLabel string `json:"registration_number_label"`
+	RegistrationNumber      string `json:"registration_number"`
+}
+
+// TaxAuthorityResponse is the data returned by CreateTaxAuthority, GetTaxAuthority and UpdateTaxAuthority
+type TaxAuthorityResponse struct {
+	Code         int64        `json:"code"`
+	Message      string       `json:"message"`
+	TaxAuthority TaxAuthority `json:"tax_authority"`
+}
+
+// ListTaxAuthoritiesResponse is the data returned by ListTaxAuthorities
+type ListTaxAuthoritiesResponse struct {
+	Code           int64          `json:"code"`
+	Message        string         `json:"message"`
+	TaxAuthorities []TaxAuthority `json:"tax_authorities"`
+}
+
+// TaxExemptionRequest is the data provided to CreateTaxExemption and UpdateTaxExemption
+type TaxExemptionRequest struct {
+	TaxExemptionCode string `json:"tax_exemption_code"`
+	Description      string `json:"description,omitempty"`
+	Type             string `json:"type"` // customer, item
+}
+
+// TaxExemption is a reason for exempting a customer or item from tax
+type TaxExemption struct {
+	TaxExemptionID   string `json:"tax_exemption_id"`
+	TaxExemptionCode string `json:"tax_exemption_code"`
+	Description      string `json:"description"`
+	Type             string `json:"type"`
+}
+
+// TaxExemptionResponse is the data returned by CreateTaxExemption, GetTaxExemption and UpdateTaxExemption
+type TaxExemptionResponse struct {
+	Code         int64        `json:"code"`
+	Message      string       `json:"message"`
+	TaxExemption TaxExemption `json:"tax_exemption"`
+}
+
+// ListTaxExemptionsResponse is the data returned by ListTaxExemptions
+type ListTaxExemptionsResponse struct {
+	Code          int64          `json:"code"`
+	Message       string         `json:"message"`
+	TaxExemptions []TaxExemption `json:"tax_exemptions"`
+}
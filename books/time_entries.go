@@ -1 +1,537 @@
 package books
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// LogTimeEntries will record time spent by a user on a task of a project
+// https://www.zoho.com/books/api/v3/time-entries/#log-time-entries
+func (c *API) LogTimeEntries(request TimeEntryRequest) (data TimeEntryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TimeEntriesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TimeEntriesModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TimeEntryResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TimeEntryResponse{}, fmt.Errorf("Failed to log time entry: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to log time entry: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// GetTimeEntry will return the time entry specified by id
+// https://www.zoho.com/books/api/v3/time-entries/#get-a-time-entry
+func (c *API) GetTimeEntry(id string) (data TimeEntryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TimeEntriesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TimeEntriesModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TimeEntryResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TimeEntryResponse{}, fmt.Errorf("Failed to retrieve time entry (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve time entry (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// ListTimeEntries will return the list of time entries matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/time-entries/#list-time-entries.
+func (c *API) ListTimeEntries(
+	params map[string]zoho.Parameter,
+) (data ListTimeEntriesResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         TimeEntriesModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, TimeEntriesModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListTimeEntriesResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"project_id":  "",
+			"user_id":     "",
+			"from_date":   "", // yyyy-mm-dd
+			"to_date":     "", // yyyy-mm-dd
+			"filter_by":   "", // Date.All, Date.Today, Date.ThisWeek, Date.ThisMonth, Date.ThisQuarter, Date.ThisYear, Date.PreviousDay, Date.PreviousWeek, Date.PreviousMonth, Date.PreviousQuarter, Date.PreviousYear, Date.CustomDate
+			"sort_column": "",
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListTimeEntriesResponse{}, fmt.Errorf("Failed to retrieve time entries: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListTimeEntriesResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve time entries: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListTimeEntriesResponse{}, fmt.Errorf("Data retrieved was not 'ListTimeEntriesResponse'")
+}
+
+// UpdateTimeEntry will modify the time entry specified by id
+// https://www.zoho.com/books/api/v3/time-entries/#update-time-entry
+func (c *API) UpdateTimeEntry(
+	id string,
+	request TimeEntryRequest,
+) (data TimeEntryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TimeEntriesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TimeEntriesModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TimeEntryResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TimeEntryResponse{}, fmt.Errorf("Failed to update time entry (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update time entry (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// DeleteTimeEntry will delete the time entry specified by id
+// https://www.zoho.com/books/api/v3/time-entries/#delete-time-entry
+func (c *API) DeleteTimeEntry(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TimeEntriesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			TimeEntriesModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete time entry (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete time entry (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// StartTimer will start the timer of the time entry specified by id
+// https://www.zoho.com/books/api/v3/time-entries/#start-timer
+func (c *API) StartTimer(id string) (data TimeEntryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TimeEntriesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/timer/start",
+			c.ZohoTLD,
+			TimeEntriesModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TimeEntryResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TimeEntryResponse{}, fmt.Errorf(
+			"Failed to start timer of time entry (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to start timer of time entry (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// StopTimer will stop the running timer of the current user
+// https://www.zoho.com/books/api/v3/time-entries/#stop-timer
+func (c *API) StopTimer() (data TimeEntryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TimeEntriesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/timer/stop",
+			c.ZohoTLD,
+			TimeEntriesModule,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TimeEntryResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TimeEntryResponse{}, fmt.Errorf("Failed to stop timer: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to stop timer: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// GetRunningTimer will return the time entry of the current user whose timer is running
+// https://www.zoho.com/books/api/v3/time-entries/#get-timer
+func (c *API) GetRunningTimer() (data TimeEntryResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: TimeEntriesModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/runningtimer/me",
+			c.ZohoTLD,
+			TimeEntriesModule,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TimeEntryResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TimeEntryResponse{}, fmt.Errorf("Failed to retrieve running timer: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TimeEntryResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve running timer: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return TimeEntryResponse{}, fmt.Errorf("Data retrieved was not 'TimeEntryResponse'")
+}
+
+// InvoiceUnbilledTimeEntries will create an invoice for all unbilled, billable time entries of the
+// project specified by projectID, according to the billing type of the project. Time entries are
+// grouped into a line item per task at the rate of the task for task hours, into a single line item
+// at the rate of the project for project hours, or into a line item per user at the rate of the
+// user for staff hours. Projects billed at a fixed cost are rejected, their time is not billed.
+// Fields set on request are used for the rest of the invoice, the customer of the project is used
+// when request.CustomerID is empty.
+// https://www.zoho.com/books/api/v3/invoices/#create-an-invoice
+func (c *API) InvoiceUnbilledTimeEntries(
+	projectID string,
+	request InvoiceRequest,
+) (data InvoiceResponse, err error) {
+	project, err := c.GetProject(projectID)
+	if err != nil {
+		return InvoiceResponse{}, fmt.Errorf(
+			"Failed to invoice time entries of project (%s): %s",
+			projectID,
+			err,
+		)
+	}
+
+	// rates holds the hourly rate of each line item, by task, user or project ID
+	rates := map[string]zoho.Money{}
+	lineKey := func(entry TimeEntry) string { return entry.TaskID }
+	lineName := func(entry TimeEntry) string { return entry.TaskName }
+
+	switch project.Project.BillingType {
+	case ProjectBillingFixedCost:
+		return InvoiceResponse{}, fmt.Errorf(
+			"Failed to invoice time entries of project (%s): billed at a fixed cost",
+			projectID,
+		)
+	case ProjectBillingProjectHours:
+		lineKey = func(TimeEntry) string { return projectID }
+		lineName = func(TimeEntry) string { return project.Project.ProjectName }
+		rates[projectID] = project.Project.Rate
+	case ProjectBillingTaskHours:
+		tasks, err := c.listAllTasks(projectID)
+		if err != nil {
+			return InvoiceResponse{}, fmt.Errorf(
+				"Failed to invoice time entries of project (%s): %s",
+				projectID,
+				err,
+			)
+		}
+		for _, task := range tasks {
+			rates[task.TaskID] = task.Rate
+		}
+	case ProjectBillingStaffHours:
+		users, err := c.ListProjectUsers(projectID)
+		if err != nil {
+			return InvoiceResponse{}, fmt.Errorf(
+				"Failed to invoice time entries of project (%s): %s",
+				projectID,
+				err,
+			)
+		}
+		for _, user := range users.Users {
+			if user.Rate != nil {
+				rates[user.UserID] = *user.Rate
+			}
+		}
+		lineKey = func(entry TimeEntry) string { return entry.UserID }
+		lineName = func(entry TimeEntry) string { return entry.UserName }
+	default:
+		return InvoiceResponse{}, fmt.Errorf(
+			"Failed to invoice time entries of project (%s): unknown billing type '%s'",
+			projectID,
+			project.Project.BillingType,
+		)
+	}
+
+	lines := map[string]*LineItem{}
+	order := []string{}
+	for page := 1; ; page++ {
+		entries, err := c.ListTimeEntries(map[string]zoho.Parameter{
+			"project_id": zoho.Parameter(projectID),
+			"page":       zoho.Parameter(strconv.Itoa(page)),
+		})
+		if err != nil {
+			return InvoiceResponse{}, fmt.Errorf(
+				"Failed to invoice time entries of project (%s): %s",
+				projectID,
+				err,
+			)
+		}
+
+		for _, entry := range entries.TimeEntries {
+			if entry.BilledStatus != "unbilled" || !entry.IsBillable {
+				continue
+			}
+			hours, err := parseLogTime(entry.LogTime)
+			if err != nil {
+				return InvoiceResponse{}, fmt.Errorf(
+					"Failed to invoice time entries of project (%s): %s",
+					projectID,
+					err,
+				)
+			}
+
+			key := lineKey(entry)
+			line, ok := lines[key]
+			if !ok {
+				rate, ok := rates[key]
+				if !ok {
+					return InvoiceResponse{}, fmt.Errorf(
+						"Failed to invoice time entries of project (%s): no rate for entry (%s)",
+						projectID,
+						entry.TimeEntryID,
+					)
+				}
+				line = &LineItem{
+					Name:      lineName(entry),
+					ProjectID: projectID,
+					Rate:      &rate,
+					Unit:      "hrs",
+				}
+				lines[key] = line
+				order = append(order, key)
+			}
+			line.Quantity += hours
+			line.TimeEntryIDs = append(line.TimeEntryIDs, entry.TimeEntryID)
+		}
+
+		if !entries.PageContext.HasMorePage {
+			break
+		}
+	}
+
+	if len(order) == 0 {
+		return InvoiceResponse{}, fmt.Errorf(
+			"Failed to invoice time entries of project (%s): no unbilled time entries",
+			projectID,
+		)
+	}
+
+	if request.CustomerID == "" {
+		request.CustomerID = project.Project.CustomerID
+	}
+	request.ProjectID = projectID
+	for _, key := range order {
+		line := lines[key]
+		line.Quantity = math.Round(line.Quantity*100) / 100
+		request.LineItems = append(request.LineItems, *line)
+	}
+
+	data, err = c.CreateInvoice(request)
+	if err != nil {
+		return data, fmt.Errorf("Failed to invoice time entries of project (%s): %s", projectID, err)
+	}
+	return data, nil
+}
+
+// listAllTasks returns the tasks of the project specified by projectID from every page
+func (c *API) listAllTasks(projectID string) ([]Task, error) {
+	var tasks []Task
+	for page := 1; ; page++ {
+		data, err := c.ListTasks(projectID, map[string]zoho.Parameter{
+			"page": zoho.Parameter(strconv.Itoa(page)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, data.Task...)
+		if !data.PageContext.HasMorePage {
+			return tasks, nil
+		}
+	}
+}
+
+// parseLogTime converts the HH:MM log time of a time entry into hours
+func parseLogTime(logTime string) (float64, error) {
+	parts := strings.SplitN(logTime, ":", 2)
+	hours, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, fmt.Errorf("invalid log time '%s': %s", logTime, err)
+	}
+	if len(parts) == 1 {
+		return float64(hours), nil
+	}
+	minutes, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid log time '%s': %s", logTime, err)
+	}
+	return float64(hours) + float64(minutes)/60, nil
+}
+
+// TimeEntryRequest is the data provided to LogTimeEntries and UpdateTimeEntry
+type TimeEntryRequest struct {
+	ProjectID  string    `json:"project_id"`
+	TaskID     string    `json:"task_id"`
+	UserID     string    `json:"user_id"`
+	LogDate    zoho.Date `json:"log_date"`             // yyyy-mm-dd
+	BeginTime  string    `json:"begin_time,omitempty"` // HH:MM
+	EndTime    string    `json:"end_time,omitempty"`   // HH:MM
+	LogTime    string    `json:"log_time,omitempty"`   // HH:MM
+	IsBillable bool      `json:"is_billable"`
+	Notes      string    `json:"notes,omitempty"`
+	StartTimer string    `json:"start_timer,omitempty"` // true, false
+}
+
+// TimeEntry is a time entry as returned by the Books API
+type TimeEntry struct {
+	TimeEntryID            string    `json:"time_entry_id"`
+	ProjectID              string    `json:"project_id"`
+	ProjectName            string    `json:"project_name"`
+	TaskID                 string    `json:"task_id"`
+	TaskName               string    `json:"task_name"`
+	UserID                 string    `json:"user_id"`
+	UserName               string    `json:"user_name"`
+	IsCurrentUser          bool      `json:"is_current_user"`
+	LogDate                zoho.Date `json:"log_date"`
+	BeginTime              string    `json:"begin_time"`
+	EndTime                string    `json:"end_time"`
+	LogTime                string    `json:"log_time"`
+	BilledStatus           string    `json:"billed_status"`
+	IsBillable             bool      `json:"is_billable"`
+	Notes                  string    `json:"notes"`
+	TimerStartedAt         zoho.Time `json:"timer_started_at"`
+	TimerDurationInMinutes int64     `json:"timer_duration_in_minutes"`
+	CustomerID             string    `json:"customer_id"`
+	CustomerName           string    `json:"customer_name"`
+	InvoiceID              string    `json:"invoice_id"`
+	InvoiceNumber          string    `json:"invoice_number"`
+	CreatedTime            zoho.Time `json:"created_time"`
+}
+
+// TimeEntryResponse is the data returned by LogTimeEntries, GetTimeEntry, UpdateTimeEntry and the timer endpoints
+type TimeEntryResponse struct {
+	Code      int64     `json:"code"`
+	Message   string    `json:"message"`
+	TimeEntry TimeEntry `json:"time_entry"`
+}
+
+// ListTimeEntriesResponse is the data returned by ListTimeEntries
+type ListTimeEntriesResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	TimeEntries []TimeEntry `json:"time_entries"`
+	PageContext PageContext `json:"page_context"`
+}
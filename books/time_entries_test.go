@@ -0,0 +1,120 @@
+package books
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestParseLogTime(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    float64
+		wantErr bool
+	}{
+		{in: "01:30", want: 1.5},
+		{in: "00:45", want: 0.75},
+		{in: "12:00", want: 12},
+		{in: "3", want: 3},
+		{in: "", wantErr: true},
+		{in: "1h:30", wantErr: true},
+		{in: "01:3o", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseLogTime(tt.in)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("parseLogTime(%q) = %v, want error", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseLogTime(%q) returned error: %s", tt.in, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseLogTime(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+const testTimeEntries = `{"code":0,"time_entries":[%s],"page_context":{"has_more_page":%t}}`
+
+func TestInvoiceUnbilledTimeEntriesByTask(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	s.Handle(http.MethodGet, "/api/v3/projects/100", zohotest.Reply(`{"code":0,"project":{
+		"project_id":"100","customer_id":"200","billing_type":"based_on_task_hours"}}`))
+	tasks := "/api/v3/projects/100/tasks"
+	s.Handle(http.MethodGet, tasks, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"code":0,"task":[{"task_id":"1","rate":40}],
+				"page_context":{"has_more_page":true}}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"task":[{"task_id":"2","rate":55.5}],
+			"page_context":{"has_more_page":false}}`))
+	})
+	entries := "/api/v3/projects/timeentries"
+	s.Handle(http.MethodGet, entries, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "1" {
+			fmt.Fprintf(w, testTimeEntries, `
+				{"time_entry_id":"a","task_id":"1","task_name":"Design","log_time":"01:30",
+					"billed_status":"unbilled","is_billable":true},
+				{"time_entry_id":"b","task_id":"1","task_name":"Design","log_time":"02:00",
+					"billed_status":"billed","is_billable":true},
+				{"time_entry_id":"c","task_id":"2","task_name":"Build","log_time":"04:00",
+					"billed_status":"unbilled","is_billable":false}`, true)
+			return
+		}
+		fmt.Fprintf(w, testTimeEntries, `
+			{"time_entry_id":"d","task_id":"2","task_name":"Build","log_time":"00:20",
+				"billed_status":"unbilled","is_billable":true},
+			{"time_entry_id":"e","task_id":"1","task_name":"Design","log_time":"00:45",
+				"billed_status":"unbilled","is_billable":true}`, false)
+	})
+	var created InvoiceRequest
+	s.Handle(http.MethodPost, "/api/v3/invoices", func(w http.ResponseWriter, r *http.Request) {
+		zohotest.DecodeJSON(t, r, &created)
+		w.Write([]byte(`{"code":0,"invoice":{"invoice_id":"300"}}`))
+	})
+
+	_, err := New(s.Client()).InvoiceUnbilledTimeEntries("100", InvoiceRequest{})
+	if err != nil {
+		t.Fatalf("InvoiceUnbilledTimeEntries returned error: %s", err)
+	}
+
+	if created.CustomerID != "200" || created.ProjectID != "100" {
+		t.Errorf("invoice customer = %q project = %q, want 200 and 100",
+			created.CustomerID, created.ProjectID)
+	}
+	if len(created.LineItems) != 2 {
+		t.Fatalf("invoice has %d lines, want one line per task", len(created.LineItems))
+	}
+
+	design, build := created.LineItems[0], created.LineItems[1]
+	if design.Name != "Design" || design.Quantity != 2.25 || design.Rate == nil ||
+		design.Rate.String() != "40" || fmt.Sprint(design.TimeEntryIDs) != "[a e]" {
+		t.Errorf("Design line = %+v", design)
+	}
+	if build.Name != "Build" || build.Quantity != 0.33 || build.Rate == nil ||
+		build.Rate.String() != "55.5" || fmt.Sprint(build.TimeEntryIDs) != "[d]" {
+		t.Errorf("Build line = %+v", build)
+	}
+}
+
+func TestInvoiceUnbilledTimeEntriesFixedCost(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+	s.Handle(http.MethodGet, "/api/v3/projects/100", zohotest.Reply(`{"code":0,"project":{
+		"project_id":"100","billing_type":"fixed_cost_for_project"}}`))
+
+	_, err := New(s.Client()).InvoiceUnbilledTimeEntries("100", InvoiceRequest{})
+	if err == nil {
+		t.Errorf("InvoiceUnbilledTimeEntries of a fixed cost project returned no error")
+	}
+}
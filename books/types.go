@@ -0,0 +1,80 @@
+package books
+
+import (
+	zoho "github.com/schmorrison/Zoho"
+	"github.com/schmorrison/Zoho/finance"
+)
+
+// Document shapes shared with the invoice package
+type (
+	CustomFieldRequest = finance.CustomFieldRequest
+	CustomField        = finance.CustomField
+	Address            = finance.Address
+	LineItem           = finance.LineItem
+	TaxSummary         = finance.TaxSummary
+	ContactPerson      = finance.ContactPerson
+	PaymentOptions     = finance.PaymentOptions
+	PaymentGateway     = finance.PaymentGateway
+)
+
+// ActionResponse is the data returned by endpoints which only report the outcome
+// of an operation, such as deleting a record or changing its status
+type ActionResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+}
+
+// EmailRequest is the data provided when emailing a document to its contacts
+type EmailRequest struct {
+	SendFromOrgEmailID bool     `json:"send_from_org_email_id,omitempty"`
+	ToMailIDs          []string `json:"to_mail_ids"`
+	CcMailIDs          []string `json:"cc_mail_ids,omitempty"`
+	Subject            string   `json:"subject,omitempty"`
+	Body               string   `json:"body,omitempty"`
+}
+
+// PageContext describes the page of results returned by list endpoints
+type PageContext struct {
+	Page           int64  `json:"page"`
+	PerPage        int64  `json:"per_page"`
+	HasMorePage    bool   `json:"has_more_page"`
+	ReportName     string `json:"report_name"`
+	AppliedFilter  string `json:"applied_filter"`
+	SortColumn     string `json:"sort_column"`
+	SortOrder      string `json:"sort_order"`
+	SearchCriteria []struct {
+		ColumnName     string `json:"column_name"`
+		SearchText     string `json:"search_text"`
+		ComparisonType string `json:"comparator"`
+	} `json:"search_criteria"`
+}
+
+// RefundRequest is the data provided when refunding money to a customer
+type RefundRequest struct {
+	Date            zoho.Date  `json:"date"`
+	RefundMode      string     `json:"refund_mode,omitempty"`
+	ReferenceNumber string     `json:"reference_number,omitempty"`
+	Amount          zoho.Money `json:"amount"`
+	ExchangeRate    float64    `json:"exchange_rate,omitempty"`
+	FromAccountID   string     `json:"from_account_id"`
+	Description     string     `json:"description,omitempty"`
+}
+
+// Refund is a refund made to a customer from a payment or credit note
+type Refund struct {
+	RefundID           string     `json:"refund_id"`
+	PaymentID          string     `json:"payment_id,omitempty"`
+	CreditnoteID       string     `json:"creditnote_id,omitempty"`
+	CreditnoteRefundID string     `json:"creditnote_refund_id,omitempty"`
+	Date               zoho.Date  `json:"date"`
+	RefundMode         string     `json:"refund_mode"`
+	ReferenceNumber    string     `json:"reference_number"`
+	Amount             zoho.Money `json:"amount"`
+	AmountBcy          zoho.Money `json:"amount_bcy"`
+	AmountFcy          zoho.Money `json:"amount_fcy"`
+	ExchangeRate       float64    `json:"exchange_rate"`
+	FromAccountID      string     `json:"from_account_id"`
+	FromAccountName    string     `json:"from_account_name"`
+	CustomerName       string     `json:"customer_name"`
+	Description        string     `json:"description"`
+}
@@ -3,6 +3,7 @@ package books
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -29,6 +30,360 @@ func (c *API) GetCurrentUser() (data CurrentUserResponse, err error) {
 	return CurrentUserResponse{}, fmt.Errorf("Data retrieved was not 'UsersResponse'")
 }
 
+// ListUsers will return the list of users of the organization matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/users/#list-users
+func (c *API) ListUsers(params map[string]zoho.Parameter) (data ListUsersResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         UsersModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, UsersModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListUsersResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"filter_by":   "", // Status.All, Status.Active, Status.Inactive, Status.Invited, Status.Deleted
+			"sort_column": "", // name, email, user_role, status
+			"page":        "1",
+			"per_page":    "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListUsersResponse{}, fmt.Errorf("Failed to retrieve users: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListUsersResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve users: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListUsersResponse{}, fmt.Errorf("Data retrieved was not 'ListUsersResponse'")
+}
+
+// GetUser will return the user specified by id
+// https://www.zoho.com/books/api/v3/users/#get-an-user
+func (c *API) GetUser(id string) (data UserResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: UsersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			UsersModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &UserResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UserResponse{}, fmt.Errorf("Failed to retrieve user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve user (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return UserResponse{}, fmt.Errorf("Data retrieved was not 'UserResponse'")
+}
+
+// CreateUser will add a user to the organization and send them an invitation
+// https://www.zoho.com/books/api/v3/users/#create-an-user
+func (c *API) CreateUser(request UserRequest) (data UserResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         UsersModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, UsersModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &UserResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UserResponse{}, fmt.Errorf("Failed to create user: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create user: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return UserResponse{}, fmt.Errorf("Data retrieved was not 'UserResponse'")
+}
+
+// UpdateUser will modify the user specified by id
+// https://www.zoho.com/books/api/v3/users/#update-an-user
+func (c *API) UpdateUser(id string, request UserRequest) (data UserResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: UsersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			UsersModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &UserResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return UserResponse{}, fmt.Errorf("Failed to update user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*UserResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update user (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return UserResponse{}, fmt.Errorf("Data retrieved was not 'UserResponse'")
+}
+
+// DeleteUser will remove the user specified by id from the organization
+// https://www.zoho.com/books/api/v3/users/#delete-an-user
+func (c *API) DeleteUser(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: UsersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			UsersModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete user (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// InviteUser will resend the invitation to the user specified by id
+// https://www.zoho.com/books/api/v3/users/#invite-an-user
+func (c *API) InviteUser(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: UsersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/invite",
+			c.ZohoTLD,
+			UsersModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to invite user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to invite user (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ActivateUser will mark the inactive user specified by id as active
+// https://www.zoho.com/books/api/v3/users/#mark-user-as-active
+func (c *API) ActivateUser(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: UsersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/active",
+			c.ZohoTLD,
+			UsersModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to activate user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to activate user (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// DeactivateUser will mark the active user specified by id as inactive
+// https://www.zoho.com/books/api/v3/users/#mark-user-as-inactive
+func (c *API) DeactivateUser(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: UsersModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/inactive",
+			c.ZohoTLD,
+			UsersModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to deactivate user (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to deactivate user (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// GetRole will return the role specified by id with its permissions. Books documents no endpoint
+// for roles, so the role is resolved from the first user of the organization it is assigned to,
+// and an error is returned when no user holds it.
+func (c *API) GetRole(id string) (data Role, err error) {
+	users, err := c.listAllUsers()
+	if err != nil {
+		return Role{}, fmt.Errorf("Failed to retrieve role (%s): %s", id, err)
+	}
+
+	for _, user := range users {
+		if user.roleID() == id {
+			role, err := c.userRole(user.UserID, id)
+			if err != nil {
+				return Role{}, fmt.Errorf("Failed to retrieve role (%s): %s", id, err)
+			}
+			return role, nil
+		}
+	}
+
+	return Role{}, fmt.Errorf("Failed to retrieve role (%s): not assigned to any user", id)
+}
+
+// ListRoles will return the roles assigned to the users of the organization with their
+// permissions, resolved from their users as in GetRole. Roles assigned to no user are not listed.
+func (c *API) ListRoles() (data []Role, err error) {
+	users, err := c.listAllUsers()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to retrieve roles: %s", err)
+	}
+
+	seen := map[string]bool{}
+	for _, user := range users {
+		id := user.roleID()
+		if id == "" || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		role, err := c.userRole(user.UserID, id)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to retrieve roles: %s", err)
+		}
+		data = append(data, role)
+	}
+
+	return data, nil
+}
+
+// listAllUsers returns the users of the organization whatever their status, from every page
+func (c *API) listAllUsers() ([]User, error) {
+	var users []User
+	for page := 1; ; page++ {
+		data, err := c.ListUsers(map[string]zoho.Parameter{
+			"filter_by": "Status.All",
+			"page":      zoho.Parameter(strconv.Itoa(page)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, data.Users...)
+		if !data.PageContext.HasMorePage {
+			return users, nil
+		}
+	}
+}
+
+// userRole returns the role specified by id from the details of the user holding it, which
+// include the permissions of the role unlike the users listed
+func (c *API) userRole(userID, id string) (Role, error) {
+	data, err := c.GetUser(userID)
+	if err != nil {
+		return Role{}, err
+	}
+	role := data.User.Role.Role
+	if role.RoleID == "" {
+		role.RoleID = id
+	}
+	return role, nil
+}
+
 func (m *MorePermissions) UnmarshalJSON(data []byte) error {
 	if string(data) == `""` {
 		return nil
@@ -46,66 +401,223 @@ type Permission struct {
 	Permission          string `json:"permission,omitempty"`
 }
 
+// Role is a named set of permissions which is assigned to users, it is returned within the
+// UserRole of a User and by GetRole and ListRoles
+type Role struct {
+	RoleID      string           `json:"role_id,omitempty"`
+	RoleName    string           `json:"role_name,omitempty"`
+	Permissions []RolePermission `json:"permissions,omitempty"`
+	Description string           `json:"description,omitempty"`
+	DisplayName string           `json:"display_name,omitempty"`
+	IsAdmin     bool             `json:"is_admin,omitempty"`
+}
+
+// RolePermission is the access a role has to a single entity (invoices, contacts, reports, etc.)
+type RolePermission struct {
+	FullAccess        bool                    `json:"full_access,omitempty"`
+	Entity            string                  `json:"entity,omitempty"`
+	MorePermissions   MorePermissions         `json:"more_permissions,omitempty"`
+	ReportPermissions []ReportPermissionGroup `json:"report_permissions,omitempty"`
+}
+
+// ReportPermissionGroup is the access a role has to the reports of a report group
+type ReportPermissionGroup struct {
+	Reports              []ReportPermission `json:"reports,omitempty"`
+	ReportGroupFormatted string             `json:"report_group_formatted,omitempty"`
+	ReportGroup          string             `json:"report_group,omitempty"`
+}
+
+// ReportPermission is the access a role has to a single report
+type ReportPermission struct {
+	FullAccess          bool   `json:"full_access,omitempty"`
+	CanSchedule         bool   `json:"can_schedule,omitempty"`
+	ReportConstant      string `json:"report_constant,omitempty"`
+	CanShare            bool   `json:"can_share,omitempty"`
+	IsExportEnabled     bool   `json:"is_export_enabled,omitempty"`
+	ReportNameFormatted string `json:"report_name_formatted,omitempty"`
+	IsScheduleEnabled   bool   `json:"is_schedule_enabled,omitempty"`
+	CanExport           bool   `json:"can_export,omitempty"`
+	CanAccess           bool   `json:"can_access,omitempty"`
+}
+
+// Report returns the permissions of the role for the report identified by its report constant
+func (r Role) Report(reportConstant string) (ReportPermission, bool) {
+	for _, p := range r.Permissions {
+		for _, group := range p.ReportPermissions {
+			for _, report := range group.Reports {
+				if report.ReportConstant == reportConstant {
+					return report, true
+				}
+			}
+		}
+	}
+	return ReportPermission{}, false
+}
+
+// CanAccessReport reports whether the role can view the report identified by its report constant
+func (r Role) CanAccessReport(reportConstant string) bool {
+	if r.IsAdmin {
+		return true
+	}
+	report, ok := r.Report(reportConstant)
+	return ok && (report.FullAccess || report.CanAccess)
+}
+
+// CanExportReport reports whether the role can export the report identified by its report constant
+func (r Role) CanExportReport(reportConstant string) bool {
+	if r.IsAdmin {
+		return true
+	}
+	report, ok := r.Report(reportConstant)
+	return ok && (report.CanExport || (report.FullAccess && report.IsExportEnabled))
+}
+
+// CanScheduleReport reports whether the role can schedule the report identified by its report constant
+func (r Role) CanScheduleReport(reportConstant string) bool {
+	if r.IsAdmin {
+		return true
+	}
+	report, ok := r.Report(reportConstant)
+	return ok && (report.CanSchedule || (report.FullAccess && report.IsScheduleEnabled))
+}
+
+// CanShareReport reports whether the role can share the report identified by its report constant
+func (r Role) CanShareReport(reportConstant string) bool {
+	if r.IsAdmin {
+		return true
+	}
+	report, ok := r.Report(reportConstant)
+	return ok && (report.FullAccess || report.CanShare)
+}
+
+// HasPermission reports whether the role is granted the permission on the entity, such as
+// HasPermission("invoice", "create"). An empty permission checks for full access to the entity.
+func (r Role) HasPermission(entity, permission string) bool {
+	if r.IsAdmin {
+		return true
+	}
+	for _, p := range r.Permissions {
+		if p.Entity != entity {
+			continue
+		}
+		if p.FullAccess {
+			return true
+		}
+		for _, more := range p.MorePermissions {
+			if permission != "" && more.Permission == permission {
+				return more.IsEnabled
+			}
+		}
+	}
+	return false
+}
+
+// UserRole is the role assigned to a user
+type UserRole struct {
+	Role   Role   `json:"role,omitempty"`
+	RoleID string `json:"role_id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Email  string `json:"email,omitempty"`
+	Zuid   string `json:"zuid,omitempty"`
+}
+
+// User is a user of the organization as returned by the Books API
+type User struct {
+	UserID   string `json:"user_id,omitempty"`
+	Name     string `json:"name,omitempty"`
+	EmailIds []struct {
+		IsSelected bool   `json:"is_selected,omitempty"`
+		Email      string `json:"email,omitempty"`
+	} `json:"email_ids,omitempty"`
+	Status              string        `json:"status,omitempty"`
+	UserRole            string        `json:"user_role,omitempty"`
+	UserType            string        `json:"user_type,omitempty"`
+	RoleID              string        `json:"role_id,omitempty"`
+	PhotoURL            string        `json:"photo_url,omitempty"`
+	Role                UserRole      `json:"role,omitempty"`
+	IsClaimant          bool          `json:"is_claimant,omitempty"`
+	IsEmployee          bool          `json:"is_employee,omitempty"`
+	Email               string        `json:"email,omitempty"`
+	IsCustomerSegmented bool          `json:"is_customer_segmented,omitempty"`
+	IsVendorSegmented   bool          `json:"is_vendor_segmented,omitempty"`
+	IsAccountant        bool          `json:"is_accountant,omitempty"`
+	IsCurrentUser       bool          `json:"is_current_user,omitempty"`
+	CostRate            *zoho.Money   `json:"cost_rate,omitempty"`
+	CreatedTime         *zoho.Time    `json:"created_time,omitempty"`
+	CustomFields        []interface{} `json:"custom_fields,omitempty"`
+	CustomFieldHash     struct {
+	} `json:"custom_field_hash,omitempty"`
+	IsAssociatedForApproval  bool          `json:"is_associated_for_approval,omitempty"`
+	IsAssociatedWithOrgEmail bool          `json:"is_associated_with_org_email,omitempty"`
+	Branches                 []interface{} `json:"branches,omitempty"`
+	DefaultBranchID          string        `json:"default_branch_id,omitempty"`
+}
+
+// roleID returns the ID of the role assigned to the user
+func (u User) roleID() string {
+	if u.RoleID != "" {
+		return u.RoleID
+	}
+	return u.Role.RoleID
+}
+
+// isAdmin reports whether the user holds the admin role, which is granted every permission
+func (u User) isAdmin() bool {
+	return u.UserRole == "admin" || u.Role.Role.IsAdmin
+}
+
+// CanAccessReport reports whether the user can view the report identified by its report constant
+func (u User) CanAccessReport(reportConstant string) bool {
+	return u.isAdmin() || u.Role.Role.CanAccessReport(reportConstant)
+}
+
+// CanExportReport reports whether the user can export the report identified by its report constant
+func (u User) CanExportReport(reportConstant string) bool {
+	return u.isAdmin() || u.Role.Role.CanExportReport(reportConstant)
+}
+
+// CanScheduleReport reports whether the user can schedule the report identified by its report constant
+func (u User) CanScheduleReport(reportConstant string) bool {
+	return u.isAdmin() || u.Role.Role.CanScheduleReport(reportConstant)
+}
+
+// CanShareReport reports whether the user can share the report identified by its report constant
+func (u User) CanShareReport(reportConstant string) bool {
+	return u.isAdmin() || u.Role.Role.CanShareReport(reportConstant)
+}
+
+// HasPermission reports whether the user is granted the permission on the entity
+func (u User) HasPermission(entity, permission string) bool {
+	return u.isAdmin() || u.Role.Role.HasPermission(entity, permission)
+}
+
 // CurrentUserResponse is the data returned by GetCurrentUser
 type CurrentUserResponse struct {
 	Code    int    `json:"code,omitempty"`
 	Message string `json:"message,omitempty"`
-	User    struct {
-		UserID   string `json:"user_id,omitempty"`
-		Name     string `json:"name,omitempty"`
-		EmailIds []struct {
-			IsSelected bool   `json:"is_selected,omitempty"`
-			Email      string `json:"email,omitempty"`
-		} `json:"email_ids,omitempty"`
-		Status   string `json:"status,omitempty"`
-		UserRole string `json:"user_role,omitempty"`
-		UserType string `json:"user_type,omitempty"`
-		RoleID   string `json:"role_id,omitempty"`
-		PhotoURL string `json:"photo_url,omitempty"`
-		Role     struct {
-			Role struct {
-				RoleName    string `json:"role_name,omitempty"`
-				Permissions []struct {
-					FullAccess        bool            `json:"full_access,omitempty"`
-					Entity            string          `json:"entity,omitempty"`
-					MorePermissions   MorePermissions `json:"more_permissions,omitempty"`
-					ReportPermissions []struct {
-						Reports []struct {
-							FullAccess          bool   `json:"full_access,omitempty"`
-							CanSchedule         bool   `json:"can_schedule,omitempty"`
-							ReportConstant      string `json:"report_constant,omitempty"`
-							CanShare            bool   `json:"can_share,omitempty"`
-							IsExportEnabled     bool   `json:"is_export_enabled,omitempty"`
-							ReportNameFormatted string `json:"report_name_formatted,omitempty"`
-							IsScheduleEnabled   bool   `json:"is_schedule_enabled,omitempty"`
-							CanExport           bool   `json:"can_export,omitempty"`
-							CanAccess           bool   `json:"can_access,omitempty"`
-						} `json:"reports,omitempty"`
-						ReportGroupFormatted string `json:"report_group_formatted,omitempty"`
-						ReportGroup          string `json:"report_group,omitempty"`
-					} `json:"report_permissions,omitempty"`
-				} `json:"permissions,omitempty"`
-				Description string `json:"description,omitempty"`
-				DisplayName string `json:"display_name,omitempty"`
-			} `json:"role,omitempty"`
-			RoleID string `json:"role_id,omitempty"`
-			Name   string `json:"name,omitempty"`
-			Email  string `json:"email,omitempty"`
-			Zuid   string `json:"zuid,omitempty"`
-		} `json:"role,omitempty"`
-		IsClaimant          bool          `json:"is_claimant,omitempty"`
-		IsEmployee          bool          `json:"is_employee,omitempty"`
-		Email               string        `json:"email,omitempty"`
-		IsCustomerSegmented bool          `json:"is_customer_segmented,omitempty"`
-		IsVendorSegmented   bool          `json:"is_vendor_segmented,omitempty"`
-		IsAccountant        bool          `json:"is_accountant,omitempty"`
-		CreatedTime         string        `json:"created_time,omitempty"`
-		CustomFields        []interface{} `json:"custom_fields,omitempty"`
-		CustomFieldHash     struct {
-		} `json:"custom_field_hash,omitempty"`
-		IsAssociatedForApproval  bool          `json:"is_associated_for_approval,omitempty"`
-		IsAssociatedWithOrgEmail bool          `json:"is_associated_with_org_email,omitempty"`
-		Branches                 []interface{} `json:"branches,omitempty"`
-		DefaultBranchID          string        `json:"default_branch_id,omitempty"`
-	} `json:"user,omitempty"`
+	User    User   `json:"user,omitempty"`
+}
+
+// UserRequest is the data provided to CreateUser and UpdateUser
+type UserRequest struct {
+	Name     string      `json:"name"`
+	Email    string      `json:"email"`
+	UserRole string      `json:"user_role,omitempty"` // admin, staff, timesheetstaff, staffassigned
+	RoleID   string      `json:"role_id,omitempty"`
+	CostRate *zoho.Money `json:"cost_rate,omitempty"`
+}
+
+// UserResponse is the data returned by GetUser, CreateUser and UpdateUser
+type UserResponse struct {
+	Code    int64  `json:"code"`
+	Message string `json:"message"`
+	User    User   `json:"user"`
+}
+
+// ListUsersResponse is the data returned by ListUsers
+type ListUsersResponse struct {
+	Code        int64       `json:"code"`
+	Message     string      `json:"message"`
+	Users       []User      `json:"users"`
+	PageContext PageContext `json:"page_context"`
 }
@@ -0,0 +1,170 @@
+package books
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+const testRole = `{
+	"role_id":"460000000000381",
+	"role_name":"Accountant",
+	"permissions":[
+		{"entity":"invoice","full_access":false,"more_permissions":[
+			{"permission":"create","is_enabled":true},
+			{"permission":"delete","is_enabled":false}
+		]},
+		{"entity":"contact","full_access":true,"more_permissions":""},
+		{"entity":"reports","report_permissions":[{"report_group":"sales","reports":[
+			{"report_constant":"sales_by_customer","can_access":true,"can_export":true},
+			{"report_constant":"sales_by_item","full_access":true,"is_export_enabled":true,
+				"is_schedule_enabled":false},
+			{"report_constant":"sales_by_salesperson","full_access":true,
+				"is_schedule_enabled":true},
+			{"report_constant":"payments_received","can_access":true,"can_share":true,
+				"can_schedule":true}
+		]}]}
+	]
+}`
+
+func TestRoleReportPermissions(t *testing.T) {
+	var role Role
+	if err := json.Unmarshal([]byte(testRole), &role); err != nil {
+		t.Fatalf("Failed to decode role: %s", err)
+	}
+
+	tests := []struct {
+		report                          string
+		access, export, schedule, share bool
+	}{
+		{report: "sales_by_customer", access: true, export: true},
+		{report: "sales_by_item", access: true, export: true, share: true},
+		{report: "sales_by_salesperson", access: true, schedule: true, share: true},
+		{report: "payments_received", access: true, schedule: true, share: true},
+		{report: "profit_and_loss"},
+	}
+
+	admin := Role{IsAdmin: true}
+	for _, tt := range tests {
+		if got := role.CanAccessReport(tt.report); got != tt.access {
+			t.Errorf("CanAccessReport(%s) = %t, want %t", tt.report, got, tt.access)
+		}
+		if got := role.CanExportReport(tt.report); got != tt.export {
+			t.Errorf("CanExportReport(%s) = %t, want %t", tt.report, got, tt.export)
+		}
+		if got := role.CanScheduleReport(tt.report); got != tt.schedule {
+			t.Errorf("CanScheduleReport(%s) = %t, want %t", tt.report, got, tt.schedule)
+		}
+		if got := role.CanShareReport(tt.report); got != tt.share {
+			t.Errorf("CanShareReport(%s) = %t, want %t", tt.report, got, tt.share)
+		}
+		if !admin.CanAccessReport(tt.report) || !admin.CanExportReport(tt.report) ||
+			!admin.CanScheduleReport(tt.report) || !admin.CanShareReport(tt.report) {
+			t.Errorf("admin role is not granted every permission on %s", tt.report)
+		}
+	}
+}
+
+func TestRoleHasPermission(t *testing.T) {
+	var role Role
+	if err := json.Unmarshal([]byte(testRole), &role); err != nil {
+		t.Fatalf("Failed to decode role: %s", err)
+	}
+
+	tests := []struct {
+		entity, permission string
+		want               bool
+	}{
+		{entity: "invoice", permission: "create", want: true},
+		{entity: "invoice", permission: "delete", want: false},
+		{entity: "invoice", permission: "", want: false},
+		{entity: "contact", permission: "delete", want: true},
+		{entity: "contact", permission: "", want: true},
+		{entity: "bill", permission: "create", want: false},
+	}
+
+	for _, tt := range tests {
+		if got := role.HasPermission(tt.entity, tt.permission); got != tt.want {
+			t.Errorf("HasPermission(%s, %s) = %t, want %t", tt.entity, tt.permission, got, tt.want)
+		}
+	}
+}
+
+func TestUserPermissions(t *testing.T) {
+	var role Role
+	if err := json.Unmarshal([]byte(testRole), &role); err != nil {
+		t.Fatalf("Failed to decode role: %s", err)
+	}
+
+	tests := []struct {
+		name   string
+		user   User
+		export bool
+		delete bool
+	}{
+		{name: "role", user: User{Role: UserRole{Role: role}}, export: false, delete: false},
+		{name: "admin user role", user: User{UserRole: "admin"}, export: true, delete: true},
+		{name: "admin role", user: User{Role: UserRole{Role: Role{IsAdmin: true}}}, export: true,
+			delete: true},
+		{name: "no role", user: User{}, export: false, delete: false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.user.CanExportReport("profit_and_loss"); got != tt.export {
+			t.Errorf("%s: CanExportReport = %t, want %t", tt.name, got, tt.export)
+		}
+		if got := tt.user.HasPermission("invoice", "delete"); got != tt.delete {
+			t.Errorf("%s: HasPermission = %t, want %t", tt.name, got, tt.delete)
+		}
+	}
+}
+
+func TestGetRole(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	s.Handle(http.MethodGet, "/api/v3/users", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("filter_by"); got != "Status.All" {
+			t.Errorf("filter_by = %s, want Status.All", got)
+		}
+		if r.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"code":0,"users":[
+				{"user_id":"1","role_id":"460000000000371","user_role":"admin"},
+				{"user_id":"2","role_id":"460000000000381"}
+			],"page_context":{"has_more_page":true}}`))
+			return
+		}
+		w.Write([]byte(`{"code":0,"users":[{"user_id":"3","role_id":"460000000000381"}],
+			"page_context":{"has_more_page":false}}`))
+	})
+	s.Handle(http.MethodGet, "/api/v3/users/1", zohotest.Reply(`{"code":0,"user":{
+		"user_id":"1","role":{"role_id":"460000000000371","role":{"role_name":"Admin",
+		"is_admin":true}}}}`))
+	s.Handle(http.MethodGet, "/api/v3/users/2", zohotest.Reply(`{"code":0,"user":{
+		"user_id":"2","role":{"role_id":"460000000000381","role":`+testRole+`}}}`))
+	api := New(s.Client())
+
+	role, err := api.GetRole("460000000000381")
+	if err != nil {
+		t.Fatalf("GetRole returned error: %s", err)
+	}
+	if role.RoleID != "460000000000381" || role.RoleName != "Accountant" ||
+		!role.CanExportReport("sales_by_customer") {
+		t.Errorf("role = %+v", role)
+	}
+
+	if _, err := api.GetRole("460000000000391"); err == nil {
+		t.Errorf("GetRole of a role assigned to no user returned no error")
+	}
+
+	roles, err := api.ListRoles()
+	if err != nil {
+		t.Fatalf("ListRoles returned error: %s", err)
+	}
+	if len(roles) != 2 || roles[0].RoleID != "460000000000371" || !roles[0].IsAdmin ||
+		roles[1].RoleID != "460000000000381" {
+		t.Errorf("roles = %+v, want the admin and accountant roles", roles)
+	}
+}
@@ -1 +1,471 @@
 package books
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// CreateVendorCredit will create a new vendor credit
+// https://www.zoho.com/books/api/v3/vendor-credits/#create-a-vendor-credit
+func (c *API) CreateVendorCredit(
+	request VendorCreditRequest,
+) (data VendorCreditResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         VendorCreditsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, VendorCreditsModule),
+		Method:       zoho.HTTPPost,
+		ResponseData: &VendorCreditResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return VendorCreditResponse{}, fmt.Errorf("Failed to create vendor credit: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to create vendor credit: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return VendorCreditResponse{}, fmt.Errorf("Data retrieved was not 'VendorCreditResponse'")
+}
+
+// GetVendorCredit will return the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#get-vendor-credit
+func (c *API) GetVendorCredit(id string) (data VendorCreditResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: VendorCreditsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			VendorCreditsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &VendorCreditResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return VendorCreditResponse{}, fmt.Errorf(
+			"Failed to retrieve vendor credit (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve vendor credit (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return VendorCreditResponse{}, fmt.Errorf("Data retrieved was not 'VendorCreditResponse'")
+}
+
+// ListVendorCredits will return the list of vendor credits matching the provided filter parameters
+// https://www.zoho.com/books/api/v3/vendor-credits/#list-vendor-credits
+func (c *API) ListVendorCredits(
+	params map[string]zoho.Parameter,
+) (data ListVendorCreditsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         VendorCreditsModule,
+		URL:          fmt.Sprintf("https://books.zoho.%s/api/v3/%s", c.ZohoTLD, VendorCreditsModule),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListVendorCreditsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"vendor_credit_number": "",
+			"vendor_id":            "",
+			"status":               "", // open, closed, void, draft
+			"filter_by":            "",
+			"search_text":          "",
+			"sort_column":          "",
+			"page":                 "1",
+			"per_page":             "200",
+		},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListVendorCreditsResponse{}, fmt.Errorf("Failed to retrieve vendor credits: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListVendorCreditsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to retrieve vendor credits: %s", v.Message)
+		}
+		return *v, nil
+	}
+
+	return ListVendorCreditsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListVendorCreditsResponse'",
+	)
+}
+
+// UpdateVendorCredit will modify the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#update-vendor-credit
+func (c *API) UpdateVendorCredit(
+	id string,
+	request VendorCreditRequest,
+) (data VendorCreditResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: VendorCreditsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			VendorCreditsModule,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &VendorCreditResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return VendorCreditResponse{}, fmt.Errorf(
+			"Failed to update vendor credit (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to update vendor credit (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return VendorCreditResponse{}, fmt.Errorf("Data retrieved was not 'VendorCreditResponse'")
+}
+
+// DeleteVendorCredit will delete the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#delete-vendor-credit
+func (c *API) DeleteVendorCredit(id string) (data ActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: VendorCreditsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s",
+			c.ZohoTLD,
+			VendorCreditsModule,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &ActionResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ActionResponse{}, fmt.Errorf("Failed to delete vendor credit (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ActionResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to delete vendor credit (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ActionResponse{}, fmt.Errorf("Data retrieved was not 'ActionResponse'")
+}
+
+// ApplyVendorCreditToBills will apply the vendor credit specified by id to one or more bills
+// https://www.zoho.com/books/api/v3/vendor-credits/#apply-credits-to-a-bill
+func (c *API) ApplyVendorCreditToBills(
+	id string,
+	request ApplyVendorCreditRequest,
+) (data ApplyVendorCreditResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: VendorCreditsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/bills",
+			c.ZohoTLD,
+			VendorCreditsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &ApplyVendorCreditResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ApplyVendorCreditResponse{}, fmt.Errorf(
+			"Failed to apply vendor credit (%s) to bills: %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ApplyVendorCreditResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to apply vendor credit (%s) to bills: %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return ApplyVendorCreditResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ApplyVendorCreditResponse'",
+	)
+}
+
+// RefundVendorCredit will record a refund received from the vendor against the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#refund-a-vendor-credit
+func (c *API) RefundVendorCredit(
+	id string,
+	request VendorCreditRefundRequest,
+) (data VendorCreditRefundResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: VendorCreditsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/refunds",
+			c.ZohoTLD,
+			VendorCreditsModule,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &VendorCreditRefundResponse{},
+		RequestBody:  request,
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return VendorCreditRefundResponse{}, fmt.Errorf(
+			"Failed to refund vendor credit (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*VendorCreditRefundResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf("Failed to refund vendor credit (%s): %s", id, v.Message)
+		}
+		return *v, nil
+	}
+
+	return VendorCreditRefundResponse{}, fmt.Errorf(
+		"Data retrieved was not 'VendorCreditRefundResponse'",
+	)
+}
+
+// ListVendorCreditRefunds will return the refunds recorded against the vendor credit specified by id
+// https://www.zoho.com/books/api/v3/vendor-credits/#list-refunds-of-a-vendor-credit
+func (c *API) ListVendorCreditRefunds(id string) (data ListVendorCreditRefundsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: VendorCreditsModule,
+		URL: fmt.Sprintf(
+			"https://books.zoho.%s/api/v3/%s/%s/refunds",
+			c.ZohoTLD,
+			VendorCreditsModule,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &ListVendorCreditRefundsResponse{},
+		Headers: map[string]string{
+			BooksAPIEndpointHeader: c.OrganizationID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return ListVendorCreditRefundsResponse{}, fmt.Errorf(
+			"Failed to retrieve refunds of vendor credit (%s): %s",
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*ListVendorCreditRefundsResponse); ok {
+		// Check if the request succeeded
+		if v.Code != 0 {
+			return *v, fmt.Errorf(
+				"Failed to retrieve refunds of vendor credit (%s): %s",
+				id,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return ListVendorCreditRefundsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'ListVendorCreditRefundsResponse'",
+	)
+}
+
+// VendorCreditRequest is the data provided to CreateVendorCredit and UpdateVendorCredit
+type VendorCreditRequest struct {
+	VendorID            string               `json:"vendor_id"`
+	CurrencyID          string               `json:"currency_id,omitempty"`
+	VendorCreditNumber  string               `json:"vendor_credit_number,omitempty"`
+	ReferenceNumber     string               `json:"reference_number,omitempty"`
+	GstTreatment        string               `json:"gst_treatment,omitempty"`
+	GstNo               string               `json:"gst_no,omitempty"`
+	SourceOfSupply      string               `json:"source_of_supply,omitempty"`
+	DestinationOfSupply string               `json:"destination_of_supply,omitempty"`
+	Date                *zoho.Date           `json:"date,omitempty"`
+	ExchangeRate        float64              `json:"exchange_rate,omitempty"`
+	IsInclusiveTax      bool                 `json:"is_inclusive_tax,omitempty"`
+	BillID              string               `json:"bill_id,omitempty"`
+	LineItems           []LineItem           `json:"line_items"`
+	Notes               string               `json:"notes,omitempty"`
+	CustomFields        []CustomFieldRequest `json:"custom_fields,omitempty"`
+}
+
+// VendorCredit is a vendor credit as returned by the Books API
+type VendorCredit struct {
+	VendorCreditID      string       `json:"vendor_credit_id"`
+	VendorCreditNumber  string       `json:"vendor_credit_number"`
+	Date                zoho.Date    `json:"date"`
+	Status              string       `json:"status"`
+	ReferenceNumber     string       `json:"reference_number"`
+	VendorID            string       `json:"vendor_id"`
+	VendorName          string       `json:"vendor_name"`
+	CurrencyID          string       `json:"currency_id"`
+	CurrencyCode        string       `json:"currency_code"`
+	ExchangeRate        float64      `json:"exchange_rate"`
+	IsInclusiveTax      bool         `json:"is_inclusive_tax"`
+	LineItems           []LineItem   `json:"line_items"`
+	SubTotal            zoho.Money   `json:"sub_total"`
+	Total               zoho.Money   `json:"total"`
+	TotalCreditsUsed    zoho.Money   `json:"total_credits_used"`
+	TotalRefundedAmount zoho.Money   `json:"total_refunded_amount"`
+	Balance             zoho.Money   `json:"balance"`
+	Taxes               []TaxSummary `json:"taxes"`
+	BillsCredited       []struct {
+		BillID        string     `json:"bill_id"`
+		BillNumber    string     `json:"bill_number"`
+		Date          zoho.Date  `json:"date"`
+		AmountApplied zoho.Money `json:"amount_applied"`
+	} `json:"bills_credited"`
+	Refunds          []VendorCreditRefund `json:"refunds"`
+	Notes            string               `json:"notes"`
+	CustomFields     []CustomField        `json:"custom_fields"`
+	CreatedTime      zoho.Time            `json:"created_time"`
+	LastModifiedTime zoho.Time            `json:"last_modified_time"`
+}
+
+// VendorCreditResponse is the data returned by CreateVendorCredit, GetVendorCredit and UpdateVendorCredit
+type VendorCreditResponse struct {
+	Code         int64        `json:"code"`
+	Message      string       `json:"message"`
+	VendorCredit VendorCredit `json:"vendor_credit"`
+}
+
+// ListVendorCreditsResponse is the data returned by ListVendorCredits
+type ListVendorCreditsResponse struct {
+	Code          int64  `json:"code"`
+	Message       string `json:"message"`
+	VendorCredits []struct {
+		VendorCreditID     string     `json:"vendor_credit_id"`
+		VendorCreditNumber string     `json:"vendor_credit_number"`
+		Status             string     `json:"status"`
+		ReferenceNumber    string     `json:"reference_number"`
+		Date               zoho.Date  `json:"date"`
+		Total              zoho.Money `json:"total"`
+		Balance            zoho.Money `json:"balance"`
+		VendorID           string     `json:"vendor_id"`
+		VendorName         string     `json:"vendor_name"`
+		CurrencyID         string     `json:"currency_id"`
+		CurrencyCode       string     `json:"currency_code"`
+		CreatedTime        zoho.Time  `json:"created_time"`
+		LastModifiedTime   zoho.Time  `json:"last_modified_time"`
+	} `json:"vendorcredits"`
+	PageContext PageContext `json:"page_context"`
+}
+
+// ApplyVendorCreditRequest is the data provided to ApplyVendorCreditToBills
+type ApplyVendorCreditRequest struct {
+	Bills []BillCredit `json:"bills"`
+}
+
+// BillCredit is the amount of a vendor credit applied to a single bill
+type BillCredit struct {
+	BillID        string     `json:"bill_id"`
+	AmountApplied zoho.Money `json:"amount_applied"`
+}
+
+// ApplyVendorCreditResponse is the data returned by ApplyVendorCreditToBills
+type ApplyVendorCreditResponse struct {
+	Code    int64        `json:"code"`
+	Message string       `json:"message"`
+	Bills   []BillCredit `json:"bills"`
+}
+
+// VendorCreditRefundRequest is the data provided to RefundVendorCredit
+type VendorCreditRefundRequest struct {
+	Date            zoho.Date  `json:"date"`
+	RefundMode      string     `json:"refund_mode,omitempty"`
+	ReferenceNumber string     `json:"reference_number,omitempty"`
+	Amount          zoho.Money `json:"amount"`
+	ExchangeRate    float64    `json:"exchange_rate,omitempty"`
+	AccountID       string     `json:"account_id"`
+	Description     string     `json:"description,omitempty"`
+}
+
+// VendorCreditRefund is a refund recorded against a vendor credit
+type VendorCreditRefund struct {
+	VendorCreditRefundID string     `json:"vendor_credit_refund_id"`
+	VendorCreditID       string     `json:"vendor_credit_id"`
+	Date                 zoho.Date  `json:"date"`
+	RefundMode           string     `json:"refund_mode"`
+	ReferenceNumber      string     `json:"reference_number"`
+	Amount               zoho.Money `json:"amount"`
+	ExchangeRate         float64    `json:"exchange_rate"`
+	AccountID            string     `json:"account_id"`
+	AccountName          string     `json:"account_name"`
+	Description          string     `json:"description"`
+}
+
+// VendorCreditRefundResponse is the data returned by RefundVendorCredit
+type VendorCreditRefundResponse struct {
+	Code               int64              `json:"code"`
+	Message            string             `json:"message"`
+	VendorCreditRefund VendorCreditRefund `json:"vendor_credit_refund"`
+}
+
+// ListVendorCreditRefundsResponse is the data returned by ListVendorCreditRefunds
+type ListVendorCreditRefundsResponse struct {
+	Code                int64                `json:"code"`
+	Message             string               `json:"message"`
+	VendorCreditRefunds []VendorCreditRefund `json:"vendor_credit_refunds"`
+	PageContext         PageContext          `json:"page_context"`
+}
@@ -0,0 +1,291 @@
+package zoho
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Comparator is an operator used to compare a field with values in search criteria
+type Comparator string
+
+// Proper names for the comparators of search criteria
+const (
+	EqualsComparator       Comparator = "equals"
+	NotEqualComparator     Comparator = "not_equal"
+	StartsWithComparator   Comparator = "starts_with"
+	InComparator           Comparator = "in"
+	BetweenComparator      Comparator = "between"
+	GreaterThanComparator  Comparator = "greater_than"
+	GreaterEqualComparator Comparator = "greater_equal"
+	LessThanComparator     Comparator = "less_than"
+	LessEqualComparator    Comparator = "less_equal"
+)
+
+// Criteria is a search criteria accepted by the search endpoints of CRM and Recruit,
+// values are escaped when the criteria is built.
+//
+//	criteria := zoho.And(
+//	    zoho.Equals("Email", "a@b.com"),
+//	    zoho.Or(zoho.StartsWith("Stage", "Won"), zoho.In("Stage", "Closed", "Lost, late")),
+//	)
+//	parameter, err := criteria.Parameter()
+//	if err != nil {
+//	    return err
+//	}
+//	crm.SearchRecords(&data, crm.DealsModule, map[string]zoho.Parameter{"criteria": parameter})
+type Criteria struct {
+	field      string
+	comparator Comparator
+	values     []interface{}
+
+	operator string // "and" or "or" when the criteria groups others
+	children []Criteria
+}
+
+// Equals matches records whose field is equal to value
+func Equals(field string, value interface{}) Criteria {
+	return Criteria{field: field, comparator: EqualsComparator, values: []interface{}{value}}
+}
+
+// NotEqual matches records whose field is not equal to value
+func NotEqual(field string, value interface{}) Criteria {
+	return Criteria{field: field, comparator: NotEqualComparator, values: []interface{}{value}}
+}
+
+// StartsWith matches records whose field starts with value
+func StartsWith(field string, value string) Criteria {
+	return Criteria{field: field, comparator: StartsWithComparator, values: []interface{}{value}}
+}
+
+// In matches records whose field is equal to one of the values, the elements of slice and array
+// values are matched as separate values
+func In(field string, values ...interface{}) Criteria {
+	return Criteria{field: field, comparator: InComparator, values: flattenValues(values)}
+}
+
+// Between matches records whose field is between from and to, both included
+func Between(field string, from, to interface{}) Criteria {
+	return Criteria{field: field, comparator: BetweenComparator, values: []interface{}{from, to}}
+}
+
+// GreaterThan matches records whose field is greater than value
+func GreaterThan(field string, value interface{}) Criteria {
+	return Criteria{field: field, comparator: GreaterThanComparator, values: []interface{}{value}}
+}
+
+// GreaterEqual matches records whose field is greater than or equal to value
+func GreaterEqual(field string, value interface{}) Criteria {
+	return Criteria{field: field, comparator: GreaterEqualComparator, values: []interface{}{value}}
+}
+
+// LessThan matches records whose field is less than value
+func LessThan(field string, value interface{}) Criteria {
+	return Criteria{field: field, comparator: LessThanComparator, values: []interface{}{value}}
+}
+
+// LessEqual matches records whose field is less than or equal to value
+func LessEqual(field string, value interface{}) Criteria {
+	return Criteria{field: field, comparator: LessEqualComparator, values: []interface{}{value}}
+}
+
+// And matches records matching all of the criteria
+func And(criteria ...Criteria) Criteria {
+	return Criteria{operator: "and", children: criteria}
+}
+
+// Or matches records matching any of the criteria
+func Or(criteria ...Criteria) Criteria {
+	return Criteria{operator: "or", children: criteria}
+}
+
+// And returns criteria matching c and all of the other criteria
+func (c Criteria) And(criteria ...Criteria) Criteria {
+	return And(append([]Criteria{c}, criteria...)...)
+}
+
+// Or returns criteria matching c or any of the other criteria
+func (c Criteria) Or(criteria ...Criteria) Criteria {
+	return Or(append([]Criteria{c}, criteria...)...)
+}
+
+// Build returns the criteria in the format expected by Zoho, such as
+// ((Email:equals:a@b.com)and(Stage:starts_with:Won))
+func (c Criteria) Build() (string, error) {
+	if c.operator != "" {
+		if len(c.children) == 0 {
+			return "", fmt.Errorf("Failed to build criteria: empty '%s' group", c.operator)
+		}
+		if len(c.children) == 1 {
+			return c.children[0].Build()
+		}
+
+		parts := make([]string, 0, len(c.children))
+		for _, child := range c.children {
+			part, err := child.Build()
+			if err != nil {
+				return "", err
+			}
+			parts = append(parts, part)
+		}
+		return "(" + strings.Join(parts, c.operator) + ")", nil
+	}
+
+	if c.field == "" {
+		return "", fmt.Errorf("Failed to build criteria: no field provided")
+	}
+	switch {
+	case c.comparator == BetweenComparator && len(c.values) != 2:
+		return "", fmt.Errorf("Failed to build criteria: between requires 2 values for %s", c.field)
+	case c.comparator == InComparator && len(c.values) == 0:
+		return "", fmt.Errorf("Failed to build criteria: in requires values for %s", c.field)
+	}
+
+	values := make([]string, 0, len(c.values))
+	for _, v := range c.values {
+		value, err := criteriaValue(v)
+		if err != nil {
+			return "", fmt.Errorf("Failed to build criteria for %s: %s", c.field, err)
+		}
+		values = append(values, value)
+	}
+	return fmt.Sprintf("(%s:%s:%s)", c.field, c.comparator, strings.Join(values, ",")), nil
+}
+
+// Parameter returns the criteria as the 'criteria' URL parameter of search endpoints, or the
+// error of Build
+func (c Criteria) Parameter() (Parameter, error) {
+	s, err := c.Build()
+	if err != nil {
+		return "", err
+	}
+	return Parameter(s), nil
+}
+
+// criteriaComparators lists the comparators allowed for each field data type
+var criteriaComparators = map[string][]Comparator{
+	"text":                {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"textarea":            {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"email":               {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"phone":               {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"website":             {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"autonumber":          {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"picklist":            {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"multiselectpicklist": {EqualsComparator, NotEqualComparator, StartsWithComparator, InComparator},
+	"lookup":              {EqualsComparator, NotEqualComparator, InComparator},
+	"ownerlookup":         {EqualsComparator, NotEqualComparator, InComparator},
+	"userlookup":          {EqualsComparator, NotEqualComparator, InComparator},
+	"boolean":             {EqualsComparator, NotEqualComparator},
+	"integer":             orderedComparators,
+	"bigint":              orderedComparators,
+	"double":              orderedComparators,
+	"currency":            orderedComparators,
+	"percent":             orderedComparators,
+	"date":                orderedComparators,
+	"datetime":            orderedComparators,
+}
+
+var orderedComparators = []Comparator{
+	EqualsComparator, NotEqualComparator, InComparator, BetweenComparator,
+	GreaterThanComparator, GreaterEqualComparator, LessThanComparator, LessEqualComparator,
+}
+
+// Validate checks the criteria against the data types of the fields of the module, provided as a map
+// of field API names to data types as returned by the module metadata ("text", "date", "currency",
+// etc.). Fields missing from the map and unknown data types are not validated.
+func (c Criteria) Validate(fieldTypes map[string]string) error {
+	if c.operator != "" {
+		for _, child := range c.children {
+			if err := child.Validate(fieldTypes); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	dataType, ok := fieldTypes[c.field]
+	if !ok {
+		return nil
+	}
+	allowed, ok := criteriaComparators[strings.ToLower(dataType)]
+	if !ok {
+		return nil
+	}
+	for _, comparator := range allowed {
+		if comparator == c.comparator {
+			return nil
+		}
+	}
+	return fmt.Errorf(
+		"Invalid criteria: %s is not supported by field %s of type %s",
+		c.comparator,
+		c.field,
+		dataType,
+	)
+}
+
+// criteriaValue returns v formatted and escaped for a search criteria
+func criteriaValue(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return escapeCriteria(value), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case time.Time:
+		return escapeCriteria(value.Format(zohoTimeLayout)), nil
+	case Time:
+		return escapeCriteria(value.String()), nil
+	case Date:
+		return value.String(), nil
+	case Money:
+		return value.String(), nil
+	case fmt.Stringer:
+		return escapeCriteria(value.String()), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	case reflect.String:
+		return escapeCriteria(rv.String()), nil
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return "null", nil
+		}
+		return criteriaValue(rv.Elem().Interface())
+	}
+	return "", fmt.Errorf("unsupported value %v of type %T", v, v)
+}
+
+// flattenValues returns values with the elements of slices and arrays in place of the slices and
+// arrays themselves
+func flattenValues(values []interface{}) []interface{} {
+	flat := make([]interface{}, 0, len(values))
+	for _, v := range values {
+		rv := reflect.ValueOf(v)
+		if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+			flat = append(flat, v)
+			continue
+		}
+		elems := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			elems = append(elems, rv.Index(i).Interface())
+		}
+		flat = append(flat, flattenValues(elems)...)
+	}
+	return flat
+}
+
+// escapeCriteria escapes the characters which delimit values in a search criteria
+func escapeCriteria(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`, `,`, `\,`).Replace(s)
+}
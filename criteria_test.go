@@ -0,0 +1,126 @@
+package zoho
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCriteriaBuild(t *testing.T) {
+	ist := time.FixedZone("", 5*3600+1800)
+	tests := []struct {
+		name     string
+		criteria Criteria
+		want     string
+	}{
+		{
+			name:     "equals",
+			criteria: Equals("Email", "a@b.com"),
+			want:     `(Email:equals:a@b.com)`,
+		},
+		{
+			name:     "escaped",
+			criteria: Equals("Company", `Acme (UK), Ltd\Inc`),
+			want:     `(Company:equals:Acme \(UK\)\, Ltd\\Inc)`,
+		},
+		{
+			name:     "in",
+			criteria: In("Stage", "Won", "Lost, late", 3),
+			want:     `(Stage:in:Won,Lost\, late,3)`,
+		},
+		{
+			name:     "in slice",
+			criteria: In("Stage", []string{"Won", "Lost, late"}, [2]int{3, 4}, "Closed"),
+			want:     `(Stage:in:Won,Lost\, late,3,4,Closed)`,
+		},
+		{
+			name:     "between",
+			criteria: Between("Amount", 10.5, NewMoney(2000, 2, "")),
+			want:     `(Amount:between:10.5,20.00)`,
+		},
+		{
+			name:     "time",
+			criteria: GreaterThan("Created_Time", time.Date(2019, 5, 9, 15, 19, 13, 0, ist)),
+			want:     `(Created_Time:greater_than:2019-05-09T15:19:13+05:30)`,
+		},
+		{
+			name:     "utc time",
+			criteria: LessThan("Modified_Time", Time(time.Date(2019, 5, 9, 9, 0, 0, 0, time.UTC))),
+			want:     `(Modified_Time:less_than:2019-05-09T09:00:00+00:00)`,
+		},
+		{
+			name:     "null",
+			criteria: Equals("Phone", nil),
+			want:     `(Phone:equals:null)`,
+		},
+		{
+			name:     "single child",
+			criteria: And(Equals("Email", "a@b.com")),
+			want:     `(Email:equals:a@b.com)`,
+		},
+		{
+			name: "nested",
+			criteria: And(
+				Equals("Email", "a@b.com"),
+				Or(StartsWith("Stage", "Won"), In("Stage", "Closed", "Lost")),
+			),
+			want: `((Email:equals:a@b.com)and((Stage:starts_with:Won)or(Stage:in:Closed,Lost)))`,
+		},
+		{
+			name:     "chained",
+			criteria: Equals("A", 1).Or(Equals("B", 2)).And(NotEqual("C", true)),
+			want:     `(((A:equals:1)or(B:equals:2))and(C:not_equal:true))`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.criteria.Build()
+		if err != nil {
+			t.Errorf("%s: Build returned error: %s", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: Build = %s, want %s", tt.name, got, tt.want)
+		}
+		if p, err := tt.criteria.Parameter(); err != nil || string(p) != tt.want {
+			t.Errorf("%s: Parameter = %s, %v, want %s", tt.name, p, err, tt.want)
+		}
+	}
+}
+
+func TestCriteriaBuildError(t *testing.T) {
+	tests := []struct {
+		name     string
+		criteria Criteria
+	}{
+		{name: "empty group", criteria: And()},
+		{name: "nested empty group", criteria: Or(Equals("A", 1), And())},
+		{name: "no field", criteria: Equals("", 1)},
+		{name: "between", criteria: Criteria{field: "A", comparator: BetweenComparator}},
+		{name: "in", criteria: In("A")},
+		{name: "in empty slice", criteria: In("A", []string{})},
+		{name: "unsupported value", criteria: Equals("A", []int{1})},
+	}
+
+	for _, tt := range tests {
+		if got, err := tt.criteria.Build(); err == nil {
+			t.Errorf("%s: Build = %s, want error", tt.name, got)
+		}
+		if got, err := tt.criteria.Parameter(); err == nil {
+			t.Errorf("%s: Parameter = %s, want error", tt.name, got)
+		}
+	}
+}
+
+func TestCriteriaValidate(t *testing.T) {
+	fields := map[string]string{"Email": "email", "Amount": "currency"}
+
+	if err := And(StartsWith("Email", "a"), Between("Amount", 1, 2)).Validate(fields); err != nil {
+		t.Errorf("Validate returned error: %s", err)
+	}
+	if err := Or(Equals("Email", "a"), StartsWith("Amount", "1")).Validate(fields); err == nil {
+		t.Errorf("Validate of starts_with on a currency field returned no error")
+	}
+	if err := GreaterThan("Unknown", 1).Validate(fields); err != nil {
+		t.Errorf("Validate of an unknown field returned error: %s", err)
+	}
+}
@@ -0,0 +1,255 @@
+package crm
+
+import (
+	"fmt"
+	"io"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// ListAttachments will return the attachments of the record specified by id in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/get-attachments.html
+func (c *API) ListAttachments(
+	module Module,
+	id string,
+	params map[string]zoho.Parameter,
+) (data AttachmentsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "attachments",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/Attachments",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &AttachmentsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"fields":   "",
+			"page":     "",
+			"per_page": "200",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AttachmentsResponse{}, fmt.Errorf(
+			"Failed to retrieve attachments of %s record (%s): %s",
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AttachmentsResponse); ok {
+		return *v, nil
+	}
+
+	return AttachmentsResponse{}, fmt.Errorf("Data retrieved was not 'AttachmentsResponse'")
+}
+
+// UploadAttachment will attach the file read from file to the record specified by id in the
+// specified module. The contents of file are streamed to Zoho, fileName is the name the attachment
+// is stored under.
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-attachment.html
+func (c *API) UploadAttachment(
+	module Module,
+	id string,
+	fileName string,
+	file io.Reader,
+) (data AttachmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "attachments",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/Attachments",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:           zoho.HTTPPost,
+		ResponseData:     &AttachmentResponse{},
+		BodyFormat:       zoho.FILE,
+		AttachmentReader: file,
+		AttachmentName:   fileName,
+		AttachmentField:  "file",
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AttachmentResponse{}, fmt.Errorf(
+			"Failed to upload attachment to %s record (%s): %s",
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AttachmentResponse); ok {
+		return *v, nil
+	}
+
+	return AttachmentResponse{}, fmt.Errorf("Data retrieved was not 'AttachmentResponse'")
+}
+
+// AttachURL will attach the link url to the record specified by id in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-attachment.html
+func (c *API) AttachURL(module Module, id string, url string) (data AttachmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "attachments",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/Attachments",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &AttachmentResponse{},
+		BodyFormat:   zoho.FORM,
+		RequestBody: &AttachURLData{
+			AttachmentURL: url,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AttachmentResponse{}, fmt.Errorf(
+			"Failed to attach url to %s record (%s): %s",
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AttachmentResponse); ok {
+		return *v, nil
+	}
+
+	return AttachmentResponse{}, fmt.Errorf("Data retrieved was not 'AttachmentResponse'")
+}
+
+// DownloadAttachment will write the attachment specified by attachmentID of the record specified
+// by id to w. The attachment is streamed from Zoho without being held in memory.
+// https://www.zoho.com/crm/developer/docs/api/v2/download-attachments.html
+func (c *API) DownloadAttachment(
+	module Module,
+	id string,
+	attachmentID string,
+	w io.Writer,
+) (err error) {
+	endpoint := zoho.Endpoint{
+		Name: "attachments",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/Attachments/%s",
+			c.ZohoTLD,
+			module,
+			id,
+			attachmentID,
+		),
+		Method:         zoho.HTTPGet,
+		ResponseData:   &Error{},
+		ResponseWriter: w,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return fmt.Errorf("Failed to download attachment (%s): %s", attachmentID, err)
+	}
+
+	// An error body is decoded when Zoho did not return the attachment
+	if v, ok := endpoint.ResponseData.(*Error); ok && v.Code != "" {
+		return fmt.Errorf(
+			"Failed to download attachment (%s): %s: %s",
+			attachmentID,
+			v.Code,
+			v.Message,
+		)
+	}
+
+	return nil
+}
+
+// DeleteAttachment will delete the attachment specified by attachmentID of the record specified
+// by id in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/delete-attachments.html
+func (c *API) DeleteAttachment(
+	module Module,
+	id string,
+	attachmentID string,
+) (data AttachmentResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "attachments",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/Attachments/%s",
+			c.ZohoTLD,
+			module,
+			id,
+			attachmentID,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &AttachmentResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return AttachmentResponse{}, fmt.Errorf(
+			"Failed to delete attachment (%s): %s",
+			attachmentID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*AttachmentResponse); ok {
+		return *v, nil
+	}
+
+	return AttachmentResponse{}, fmt.Errorf("Data retrieved was not 'AttachmentResponse'")
+}
+
+// AttachURLData is the data provided to AttachURL
+type AttachURLData struct {
+	AttachmentURL string `url:"attachmentUrl"`
+}
+
+// Attachment is a file or link attached to a record
+type Attachment struct {
+	ID           string `json:"id,omitempty"`
+	FileName     string `json:"File_Name,omitempty"`
+	Size         string `json:"Size,omitempty"`
+	FileID       string `json:"$file_id,omitempty"`
+	Type         string `json:"$type,omitempty"`
+	SeModule     string `json:"$se_module,omitempty"`
+	LinkURL      string `json:"$link_url,omitempty"`
+	Editable     bool   `json:"$editable,omitempty"`
+	ParentID     Lookup `json:"Parent_Id,omitempty"`
+	Owner        Owner  `json:"Owner,omitempty"`
+	CreatedBy    Owner  `json:"Created_By,omitempty"`
+	ModifiedBy   Owner  `json:"Modified_By,omitempty"`
+	CreatedTime  *Time  `json:"Created_Time,omitempty"`
+	ModifiedTime *Time  `json:"Modified_Time,omitempty"`
+}
+
+// AttachmentsResponse is the data returned by ListAttachments
+type AttachmentsResponse struct {
+	Data []Attachment `json:"data,omitempty"`
+	Info PageInfo     `json:"info,omitempty"`
+}
+
+// AttachmentResponse is the data returned by UploadAttachment, AttachURL and DeleteAttachment
+type AttachmentResponse struct {
+	Data []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			ID           string `json:"id,omitempty"`
+			CreatedBy    Owner  `json:"Created_By,omitempty"`
+			ModifiedBy   Owner  `json:"Modified_By,omitempty"`
+			CreatedTime  *Time  `json:"Created_Time,omitempty"`
+			ModifiedTime *Time  `json:"Modified_Time,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"data,omitempty"`
+}
@@ -0,0 +1,35 @@
+package crm
+
+import (
+	"mime"
+	"net/http"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestAttachURL(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+	path := "/crm/v2/Leads/1000000231/Attachments"
+	s.Handle(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "multipart/form-data" {
+			t.Errorf("Content-Type = %s, want multipart/form-data", r.Header.Get("Content-Type"))
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("Failed to parse form: %s", err)
+			return
+		}
+		if got := r.FormValue("attachmentUrl"); got != "https://example.com/a?b=c&d" {
+			t.Errorf("attachmentUrl = %s, want https://example.com/a?b=c&d", got)
+		}
+		w.Write([]byte(`{"data":[{"code":"SUCCESS","details":{"id":"1"},"status":"success"}]}`))
+	})
+
+	api := New(s.Client())
+	_, err := api.AttachURL(LeadsModule, "1000000231", "https://example.com/a?b=c&d")
+	if err != nil {
+		t.Fatalf("AttachURL returned error: %s", err)
+	}
+}
@@ -0,0 +1,320 @@
+package crm
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// BulkJobState is the processing state of a bulk read or write job
+type BulkJobState string
+
+// Proper names for the states of bulk jobs
+const (
+	BulkJobAdded      BulkJobState = "ADDED"
+	BulkJobQueued     BulkJobState = "QUEUED"
+	BulkJobInProgress BulkJobState = "IN PROGRESS"
+	BulkJobCompleted  BulkJobState = "COMPLETED"
+	BulkJobFailed     BulkJobState = "FAILED"
+)
+
+// BulkCallback is the URL notified by Zoho when a bulk job completes or fails
+type BulkCallback struct {
+	URL    string `json:"url"`
+	Method string `json:"method"` // only "post" is supported
+}
+
+// downloadBulkFile will download the zip archive at url into a temporary file, the caller
+// is responsible for removing the file
+func (c *API) downloadBulkFile(name, url string) (path string, err error) {
+	file, err := ioutil.TempFile("", "zoho-crm-bulk-*.zip")
+	if err != nil {
+		return "", fmt.Errorf("Failed to create file for %s: %s", name, err)
+	}
+	defer file.Close()
+
+	endpoint := zoho.Endpoint{
+		Name:           name,
+		URL:            url,
+		Method:         zoho.HTTPGet,
+		ResponseData:   &BulkErrorResponse{},
+		ResponseWriter: file,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("Failed to download %s: %s", name, err)
+	}
+
+	// Errors are reported as JSON instead of the archive
+	if v, ok := endpoint.ResponseData.(*BulkErrorResponse); ok && v.Code != "" {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("Failed to download %s: %s: %s", name, v.Code, v.Message)
+	}
+
+	return file.Name(), nil
+}
+
+// BulkErrorResponse is the data returned by the bulk endpoints when a request fails
+type BulkErrorResponse struct {
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+// BulkRows iterates over the rows of the CSV files of a bulk job archive without loading it
+// in memory.
+//
+//	rows, err := c.ReadBulkReadResult(jobID)
+//	if err != nil {
+//	    return err
+//	}
+//	defer rows.Close()
+//	for rows.Next() {
+//	    lead := Lead{}
+//	    if err := rows.Decode(&lead); err != nil {
+//	        return err
+//	    }
+//	}
+//	return rows.Err()
+type BulkRows struct {
+	path    string
+	archive *zip.ReadCloser
+	files   []*zip.File
+	current io.ReadCloser
+	reader  *csv.Reader
+	header  []string
+	record  []string
+	err     error
+}
+
+// openBulkRows opens the zip archive at path, which is removed when the rows are closed
+func openBulkRows(path string) (*BulkRows, error) {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		os.Remove(path)
+		return nil, fmt.Errorf("Failed to open bulk archive: %s", err)
+	}
+
+	rows := &BulkRows{path: path, archive: archive}
+	for _, f := range archive.File {
+		if strings.HasSuffix(strings.ToLower(f.Name), ".csv") {
+			rows.files = append(rows.files, f)
+		}
+	}
+	return rows, nil
+}
+
+// Next prepares the next row, it returns false when there are no more rows or an error occurred
+func (r *BulkRows) Next() bool {
+	for r.err == nil {
+		if r.reader == nil && !r.nextFile() {
+			return false
+		}
+
+		record, err := r.reader.Read()
+		if err == io.EOF {
+			r.current.Close()
+			r.current, r.reader = nil, nil
+			continue
+		}
+		if err != nil {
+			r.err = fmt.Errorf("Failed to read bulk row: %s", err)
+			return false
+		}
+		r.record = record
+		return true
+	}
+	return false
+}
+
+// nextFile opens the next CSV file of the archive and reads its header
+func (r *BulkRows) nextFile() bool {
+	if len(r.files) == 0 {
+		return false
+	}
+	f := r.files[0]
+	r.files = r.files[1:]
+
+	rc, err := f.Open()
+	if err != nil {
+		r.err = fmt.Errorf("Failed to open %s of bulk archive: %s", f.Name, err)
+		return false
+	}
+
+	reader := csv.NewReader(rc)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err == io.EOF {
+		rc.Close()
+		return r.nextFile()
+	}
+	if err != nil {
+		rc.Close()
+		r.err = fmt.Errorf("Failed to read header of %s: %s", f.Name, err)
+		return false
+	}
+
+	// Strip the byte order mark Zoho writes at the start of the file
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	r.current, r.reader, r.header = rc, reader, header
+	return true
+}
+
+// Header returns the column names of the current row
+func (r *BulkRows) Header() []string {
+	return r.header
+}
+
+// Record returns the values of the current row
+func (r *BulkRows) Record() []string {
+	return r.record
+}
+
+// Map returns the current row as a map of column names to values
+func (r *BulkRows) Map() map[string]string {
+	row := make(map[string]string, len(r.header))
+	for i, column := range r.header {
+		if i < len(r.record) {
+			row[column] = r.record[i]
+		}
+	}
+	return row
+}
+
+// Decode stores the current row in the struct pointed to by v, columns are matched with
+// the json tags of its fields and empty values are skipped
+func (r *BulkRows) Decode(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("Failed, you must pass a pointer to a struct to decode a bulk row")
+	}
+
+	fields := map[string]reflect.Value{}
+	collectBulkFields(rv.Elem(), fields)
+
+	for i, column := range r.header {
+		field, ok := fields[column]
+		if !ok || i >= len(r.record) || r.record[i] == "" {
+			continue
+		}
+		if err := setBulkField(field, r.record[i]); err != nil {
+			return fmt.Errorf("Failed to decode column %s: %s", column, err)
+		}
+	}
+	return nil
+}
+
+// Err returns the error met while iterating over the rows
+func (r *BulkRows) Err() error {
+	return r.err
+}
+
+// Close releases the archive and removes its file
+func (r *BulkRows) Close() error {
+	if r.current != nil {
+		r.current.Close()
+	}
+	err := r.archive.Close()
+	os.Remove(r.path)
+	return err
+}
+
+// collectBulkFields maps the json names of the fields of the struct v, including those of
+// embedded structs, to the fields
+func collectBulkFields(v reflect.Value, fields map[string]reflect.Value) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" || (f.PkgPath != "" && !f.Anonymous) {
+			continue
+		}
+		if f.Anonymous && name == "" && f.Type.Kind() == reflect.Struct {
+			collectBulkFields(v.Field(i), fields)
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = v.Field(i)
+		}
+	}
+}
+
+// setBulkField sets the field from the CSV value s
+func setBulkField(field reflect.Value, s string) error {
+	if field.Kind() == reflect.Ptr {
+		value := reflect.New(field.Type().Elem())
+		if err := setBulkField(value.Elem(), s); err != nil {
+			return err
+		}
+		field.Set(value)
+		return nil
+	}
+
+	if u, ok := field.Addr().Interface().(json.Unmarshaler); ok {
+		// Numbers are provided as such so that amounts are not marked as quoted
+		if _, err := strconv.ParseFloat(s, 64); err == nil && json.Valid([]byte(s)) &&
+			u.UnmarshalJSON([]byte(s)) == nil {
+			return nil
+		}
+		quoted, _ := json.Marshal(s)
+		return u.UnmarshalJSON(quoted)
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		field.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	case reflect.Slice:
+		// Multi-select values are separated by semicolons
+		if field.Type().Elem().Kind() != reflect.String {
+			return nil
+		}
+		values := strings.Split(s, ";")
+		slice := reflect.MakeSlice(field.Type(), len(values), len(values))
+		for i, value := range values {
+			slice.Index(i).SetString(value)
+		}
+		field.Set(slice)
+	}
+	return nil
+}
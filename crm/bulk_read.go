@@ -0,0 +1,237 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// Bulk read jobs are polled every 5 seconds at first, then less often up to once a minute
+const (
+	bulkPollInterval    = 5 * time.Second
+	bulkMaxPollInterval = time.Minute
+)
+
+// CreateBulkReadJob will schedule the export of the records matching the query of request, the
+// job ID returned is used to follow its progress and download the result. It requires the
+// zoho.BuildScope(zoho.Crm, zoho.BulkScope, "", zoho.Read) scope.
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-read/create-job.html
+func (c *API) CreateBulkReadJob(request BulkReadJobRequest) (data BulkReadJob, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "CreateBulkReadJob",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/read", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &BulkJobResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkReadJob{}, fmt.Errorf("Failed to create bulk read job: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkJobResponse); ok {
+		if len(v.Data) == 0 {
+			return BulkReadJob{}, fmt.Errorf(
+				"Failed to create bulk read job: %s: %s",
+				v.Code,
+				v.Message,
+			)
+		}
+		job := BulkReadJob{}
+		if err := json.Unmarshal(v.Data[0].Details, &job); err != nil {
+			return BulkReadJob{}, fmt.Errorf("Failed to decode bulk read job: %s", err)
+		}
+		return job, nil
+	}
+
+	return BulkReadJob{}, fmt.Errorf("Data retrieved was not 'BulkJobResponse'")
+}
+
+// GetBulkReadJob will return the details and state of the bulk read job
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-read/get-job-details.html
+func (c *API) GetBulkReadJob(id string) (data BulkReadJob, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "GetBulkReadJob",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/read/%s", c.ZohoTLD, id),
+		Method:       zoho.HTTPGet,
+		ResponseData: &BulkReadJobResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkReadJob{}, fmt.Errorf("Failed to retrieve bulk read job: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkReadJobResponse); ok {
+		if len(v.Data) == 0 {
+			return BulkReadJob{}, fmt.Errorf(
+				"Failed to retrieve bulk read job: %s: %s",
+				v.Code,
+				v.Message,
+			)
+		}
+		return v.Data[0], nil
+	}
+
+	return BulkReadJob{}, fmt.Errorf("Data retrieved was not 'BulkReadJobResponse'")
+}
+
+// WaitForBulkReadJob will poll the bulk read job with an increasing interval until it completes
+// or fails, giving up after timeout. Use a callback in the BulkReadJobRequest to be notified
+// instead of polling.
+func (c *API) WaitForBulkReadJob(id string, timeout time.Duration) (data BulkReadJob, err error) {
+	deadline := time.Now().Add(timeout)
+	interval := bulkPollInterval
+	for {
+		job, err := c.GetBulkReadJob(id)
+		if err != nil {
+			return BulkReadJob{}, err
+		}
+
+		switch job.State {
+		case BulkJobCompleted:
+			return job, nil
+		case BulkJobFailed:
+			return job, fmt.Errorf("Bulk read job %s failed", id)
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return job, fmt.Errorf(
+				"Timed out waiting for bulk read job %s in state %s",
+				id,
+				job.State,
+			)
+		}
+		time.Sleep(interval)
+
+		if interval *= 2; interval > bulkMaxPollInterval {
+			interval = bulkMaxPollInterval
+		}
+	}
+}
+
+// ReadBulkReadResult will download the zipped CSV file of the completed bulk read job to a
+// temporary file and return its rows, which are read as they are iterated over. Close the rows
+// to remove the file. As the download may be large, the HTTP client of the API should not be
+// limited by a short timeout.
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-read/download-result.html
+func (c *API) ReadBulkReadResult(id string) (rows *BulkRows, err error) {
+	path, err := c.downloadBulkFile(
+		"ReadBulkReadResult",
+		fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/read/%s/result", c.ZohoTLD, id),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return openBulkRows(path)
+}
+
+// ParseBulkReadCallback will decode the notification sent by Zoho to the callback URL of a
+// bulk read job
+func ParseBulkReadCallback(r *http.Request) (data BulkReadJob, err error) {
+	defer r.Body.Close()
+
+	job := BulkReadJob{}
+	if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+		return BulkReadJob{}, fmt.Errorf("Failed to decode bulk read callback: %s", err)
+	}
+	if job.ID == "" {
+		job.ID = job.JobID
+	}
+	return job, nil
+}
+
+// BulkReadJobRequest is the data provided to CreateBulkReadJob
+type BulkReadJobRequest struct {
+	Callback *BulkCallback `json:"callback,omitempty"`
+	Query    BulkReadQuery `json:"query"`
+	FileType string        `json:"file_type,omitempty"` // "csv" or "ics" for events
+}
+
+// BulkReadQuery selects the records exported by a bulk read job. A job exports at most 200,000
+// records, when the result reports more records create a new job for the next page.
+type BulkReadQuery struct {
+	Module   Module            `json:"module"`
+	CVID     string            `json:"cvid,omitempty"`
+	Fields   []string          `json:"fields,omitempty"`
+	Page     int               `json:"page,omitempty"`
+	Criteria *BulkReadCriteria `json:"criteria,omitempty"`
+}
+
+// BulkReadCriteria filters the records of a bulk read job, either comparing a field with a value
+// or combining a group of criteria with GroupOperator ("and" or "or")
+type BulkReadCriteria struct {
+	APIName       string             `json:"api_name,omitempty"`
+	Comparator    BulkReadComparator `json:"comparator,omitempty"`
+	Value         interface{}        `json:"value,omitempty"`
+	GroupOperator string             `json:"group_operator,omitempty"`
+	Group         []BulkReadCriteria `json:"group,omitempty"`
+}
+
+// BulkReadComparator is an operator used to compare a field with a value in the criteria of a
+// bulk read job, they differ from the comparators of search criteria
+type BulkReadComparator string
+
+// Proper names for the comparators of bulk read criteria
+const (
+	BulkReadEqual        BulkReadComparator = "equal"
+	BulkReadNotEqual     BulkReadComparator = "not_equal"
+	BulkReadIn           BulkReadComparator = "in"
+	BulkReadNotIn        BulkReadComparator = "not_in"
+	BulkReadLessThan     BulkReadComparator = "less_than"
+	BulkReadLessEqual    BulkReadComparator = "less_equal"
+	BulkReadGreaterThan  BulkReadComparator = "greater_than"
+	BulkReadGreaterEqual BulkReadComparator = "greater_equal"
+	BulkReadContains     BulkReadComparator = "contains"
+	BulkReadNotContains  BulkReadComparator = "not_contains"
+	BulkReadStartsWith   BulkReadComparator = "starts_with"
+	BulkReadEndsWith     BulkReadComparator = "ends_with"
+	BulkReadBetween      BulkReadComparator = "between"
+	BulkReadNotBetween   BulkReadComparator = "not_between"
+)
+
+// BulkReadJob is the data describing a bulk read job
+type BulkReadJob struct {
+	ID        string        `json:"id,omitempty"`
+	JobID     string        `json:"job_id,omitempty"` // set in callbacks
+	Operation string        `json:"operation,omitempty"`
+	State     BulkJobState  `json:"state,omitempty"`
+	Query     BulkReadQuery `json:"query,omitempty"`
+	CreatedBy struct {
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"created_by,omitempty"`
+	CreatedTime *Time           `json:"created_time,omitempty"`
+	FileType    string          `json:"file_type,omitempty"`
+	Result      *BulkReadResult `json:"result,omitempty"`
+}
+
+// BulkReadResult is the outcome of a completed bulk read job
+type BulkReadResult struct {
+	Page        int    `json:"page,omitempty"`
+	Count       int    `json:"count,omitempty"`
+	DownloadURL string `json:"download_url,omitempty"`
+	PerPage     int    `json:"per_page,omitempty"`
+	MoreRecords bool   `json:"more_records,omitempty"`
+}
+
+// BulkJobResponse is the data returned when creating a bulk job
+type BulkJobResponse struct {
+	Data []struct {
+		Status  string          `json:"status,omitempty"`
+		Code    string          `json:"code,omitempty"`
+		Message string          `json:"message,omitempty"`
+		Details json.RawMessage `json:"details,omitempty"`
+	} `json:"data,omitempty"`
+	BulkErrorResponse
+}
+
+// BulkReadJobResponse is the data returned by GetBulkReadJob
+type BulkReadJobResponse struct {
+	Data []BulkReadJob `json:"data,omitempty"`
+	BulkErrorResponse
+}
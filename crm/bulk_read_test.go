@@ -0,0 +1,67 @@
+package crm
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestCreateBulkReadJobCriteria(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var body struct {
+		Query struct {
+			Criteria json.RawMessage `json:"criteria"`
+		} `json:"query"`
+	}
+	s.Handle(http.MethodPost, "/crm/bulk/v2/read", func(w http.ResponseWriter, r *http.Request) {
+		zohotest.DecodeJSON(t, r, &body)
+		w.Write([]byte(`{"data":[{"status":"success","code":"ADDED_SUCCESSFULLY",
+			"details":{"id":"1000000001","operation":"read","state":"ADDED"}}]}`))
+	})
+
+	job, err := New(s.Client()).CreateBulkReadJob(BulkReadJobRequest{
+		Query: BulkReadQuery{
+			Module: LeadsModule,
+			Criteria: &BulkReadCriteria{
+				GroupOperator: "and",
+				Group: []BulkReadCriteria{
+					{APIName: "Lead_Source", Comparator: BulkReadEqual, Value: "Web"},
+					{APIName: "Last_Name", Comparator: BulkReadNotContains, Value: "test"},
+					{
+						APIName:    "Created_Time",
+						Comparator: BulkReadBetween,
+						Value:      []string{"2019-05-01T00:00:00+00:00", "2019-05-31T00:00:00+00:00"},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateBulkReadJob returned error: %s", err)
+	}
+	if job.ID != "1000000001" || job.State != BulkJobAdded {
+		t.Errorf("job = %+v", job)
+	}
+
+	want := `{"group_operator":"and","group":[
+		{"api_name":"Lead_Source","comparator":"equal","value":"Web"},
+		{"api_name":"Last_Name","comparator":"not_contains","value":"test"},
+		{"api_name":"Created_Time","comparator":"between",
+			"value":["2019-05-01T00:00:00+00:00","2019-05-31T00:00:00+00:00"]}
+	]}`
+	var got, wanted interface{}
+	if err := json.Unmarshal(body.Query.Criteria, &got); err != nil {
+		t.Fatalf("Failed to decode criteria %s: %s", body.Query.Criteria, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wanted); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, wanted) {
+		t.Errorf("criteria = %s, want %s", body.Query.Criteria, want)
+	}
+}
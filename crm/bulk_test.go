@@ -0,0 +1,132 @@
+package crm
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// writeBulkArchive writes a zip archive holding the files and returns its path
+func writeBulkArchive(t *testing.T, files [][2]string) string {
+	f, err := ioutil.TempFile("", "zoho-crm-bulk-test-*.zip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	w := zip.NewWriter(f)
+	for _, file := range files {
+		fw, err := w.Create(file[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := fw.Write([]byte(file[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+type bulkTestRecord struct {
+	ID string `json:"id"`
+}
+
+type bulkTestLead struct {
+	bulkTestRecord
+	LastName    string      `json:"Last_Name"`
+	Employees   int         `json:"No_of_Employees"`
+	Revenue     *Currency   `json:"Annual_Revenue"`
+	OptOut      bool        `json:"Email_Opt_Out"`
+	Interests   MultiSelect `json:"Interests"`
+	CreatedTime *Time       `json:"Created_Time"`
+	Phone       Phone       `json:"Phone"`
+	Ignored     string      `json:"-"`
+}
+
+func TestBulkRowsDecode(t *testing.T) {
+	path := writeBulkArchive(t, [][2]string{
+		{"README.txt", "not a csv"},
+		{"1.csv", "\ufeffid,Last_Name,No_of_Employees,Annual_Revenue,Email_Opt_Out,Interests," +
+			"Created_Time,Phone,Ignored\n" +
+			"1,\"O'Neil, Jr\",25,1500.50,true,CRM;Books,2019-05-09T15:19:13+05:30,555,x\n"},
+		{"2.csv", ""},
+		{"3.csv", "id,Last_Name,No_of_Employees\n2,Smith,\n"},
+	})
+	rows, err := openBulkRows(path)
+	if err != nil {
+		t.Fatalf("openBulkRows returned error: %s", err)
+	}
+
+	var leads []bulkTestLead
+	for rows.Next() {
+		lead := bulkTestLead{}
+		if err := rows.Decode(&lead); err != nil {
+			t.Fatalf("Decode returned error: %s", err)
+		}
+		leads = append(leads, lead)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("Err returned error: %s", err)
+	}
+	if len(leads) != 2 {
+		t.Fatalf("decoded %d rows, want 2", len(leads))
+	}
+
+	lead := leads[0]
+	if lead.ID != "1" || lead.LastName != "O'Neil, Jr" || lead.Employees != 25 || !lead.OptOut ||
+		lead.Phone != "555" || lead.Ignored != "" {
+		t.Errorf("lead = %+v", lead)
+	}
+	if lead.Revenue == nil || lead.Revenue.String() != "1500.50" {
+		t.Errorf("Annual_Revenue = %v, want 1500.50", lead.Revenue)
+	}
+	if !reflect.DeepEqual(lead.Interests, MultiSelect{"CRM", "Books"}) {
+		t.Errorf("Interests = %v, want [CRM Books]", lead.Interests)
+	}
+	created := time.Date(2019, 5, 9, 9, 49, 13, 0, time.UTC)
+	if lead.CreatedTime == nil || !lead.CreatedTime.Std().Equal(created) {
+		t.Errorf("Created_Time = %v, want %s", lead.CreatedTime, created)
+	}
+
+	// Empty values are skipped
+	if leads[1].ID != "2" || leads[1].LastName != "Smith" || leads[1].Employees != 0 ||
+		leads[1].Revenue != nil {
+		t.Errorf("lead = %+v", leads[1])
+	}
+	want := map[string]string{"id": "2", "Last_Name": "Smith", "No_of_Employees": ""}
+	if !reflect.DeepEqual(rows.Map(), want) {
+		t.Errorf("Map = %v, want %v", rows.Map(), want)
+	}
+
+	if err := rows.Close(); err != nil {
+		t.Errorf("Close returned error: %s", err)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("archive was not removed when closed")
+	}
+}
+
+func TestBulkRowsDecodeError(t *testing.T) {
+	path := writeBulkArchive(t, [][2]string{{"1.csv", "id,No_of_Employees\n1,many\n"}})
+	rows, err := openBulkRows(path)
+	if err != nil {
+		t.Fatalf("openBulkRows returned error: %s", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		t.Fatalf("Next returned false: %v", rows.Err())
+	}
+	if err := rows.Decode(&bulkTestLead{}); err == nil {
+		t.Errorf("Decode of an invalid number returned no error")
+	}
+	if err := rows.Decode(bulkTestLead{}); err == nil {
+		t.Errorf("Decode of a struct value returned no error")
+	}
+}
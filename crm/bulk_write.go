@@ -0,0 +1,327 @@
+package crm
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// BulkWriteOperation is the kind of changes made by a bulk write job
+type BulkWriteOperation string
+
+// Proper names for bulk write operations
+const (
+	BulkInsert BulkWriteOperation = "insert"
+	BulkUpdate BulkWriteOperation = "update"
+	BulkUpsert BulkWriteOperation = "upsert"
+)
+
+// UploadBulkWriteFile will upload the zipped CSV file read from zipped for use in a bulk write
+// job, orgID is the Zoho CRM organization ID. The file ID returned is provided in the resource
+// of CreateBulkWriteJob. It requires the zoho.BuildScope(zoho.Crm, zoho.BulkScope, "", zoho.All)
+// scope along with the scopes of the modules written.
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/upload-file.html
+func (c *API) UploadBulkWriteFile(
+	orgID string,
+	fileName string,
+	zipped io.Reader,
+) (data BulkUploadResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:             "UploadBulkWriteFile",
+		URL:              fmt.Sprintf("https://content.zohoapis.%s/crm/v2/upload", c.ZohoTLD),
+		Method:           zoho.HTTPPost,
+		ResponseData:     &BulkUploadResponse{},
+		BodyFormat:       zoho.FILE,
+		AttachmentReader: zipped,
+		AttachmentName:   fileName,
+		AttachmentField:  "file",
+		Headers: map[string]string{
+			"feature":   "bulk-write",
+			"X-CRM-ORG": orgID,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkUploadResponse{}, fmt.Errorf("Failed to upload bulk write file: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkUploadResponse); ok {
+		if v.Details.FileID == "" {
+			return *v, fmt.Errorf("Failed to upload bulk write file: %s: %s", v.Code, v.Message)
+		}
+		return *v, nil
+	}
+
+	return BulkUploadResponse{}, fmt.Errorf("Data retrieved was not 'BulkUploadResponse'")
+}
+
+// UploadBulkWriteCSV will zip the CSV file read from csv as it is uploaded for use in a bulk
+// write job, see UploadBulkWriteFile
+func (c *API) UploadBulkWriteCSV(
+	orgID string,
+	fileName string,
+	csv io.Reader,
+) (data BulkUploadResponse, err error) {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	go func() {
+		pw.CloseWithError(zipBulkFile(pw, fileName, csv))
+	}()
+
+	return c.UploadBulkWriteFile(orgID, fileName+".zip", pr)
+}
+
+// zipBulkFile writes r as the file name of a zip archive into w
+func zipBulkFile(w io.Writer, name string, r io.Reader) error {
+	archive := zip.NewWriter(w)
+	file, err := archive.Create(name)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(file, r); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// CreateBulkWriteJob will schedule the records of the uploaded files described by the resources
+// of request to be inserted, updated or upserted
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/create-job.html
+func (c *API) CreateBulkWriteJob(request BulkWriteJobRequest) (data BulkWriteJob, err error) {
+	if request.CharacterEncoding == "" {
+		request.CharacterEncoding = "UTF-8"
+	}
+
+	endpoint := zoho.Endpoint{
+		Name:         "CreateBulkWriteJob",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/write", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &BulkWriteJobResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkWriteJob{}, fmt.Errorf("Failed to create bulk write job: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkWriteJobResponse); ok {
+		if v.Details.ID == "" {
+			return BulkWriteJob{}, fmt.Errorf(
+				"Failed to create bulk write job: %s: %s",
+				v.Code,
+				v.Message,
+			)
+		}
+		return v.Details, nil
+	}
+
+	return BulkWriteJob{}, fmt.Errorf("Data retrieved was not 'BulkWriteJobResponse'")
+}
+
+// GetBulkWriteJob will return the details and state of the bulk write job, including the number
+// of records added, updated and skipped for each resource
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/get-job-details.html
+func (c *API) GetBulkWriteJob(id string) (data BulkWriteJob, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "GetBulkWriteJob",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/bulk/v2/write/%s", c.ZohoTLD, id),
+		Method:       zoho.HTTPGet,
+		ResponseData: &BulkWriteJob{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return BulkWriteJob{}, fmt.Errorf("Failed to retrieve bulk write job: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*BulkWriteJob); ok {
+		if v.ID == "" {
+			return BulkWriteJob{}, fmt.Errorf(
+				"Failed to retrieve bulk write job: %s: %s",
+				v.Code,
+				v.Message,
+			)
+		}
+		return *v, nil
+	}
+
+	return BulkWriteJob{}, fmt.Errorf("Data retrieved was not 'BulkWriteJob'")
+}
+
+// WaitForBulkWriteJob will poll the bulk write job with an increasing interval until it completes
+// or fails, giving up after timeout
+func (c *API) WaitForBulkWriteJob(id string, timeout time.Duration) (data BulkWriteJob, err error) {
+	deadline := time.Now().Add(timeout)
+	interval := bulkPollInterval
+	for {
+		job, err := c.GetBulkWriteJob(id)
+		if err != nil {
+			return BulkWriteJob{}, err
+		}
+
+		switch job.Status {
+		case BulkJobCompleted:
+			return job, nil
+		case BulkJobFailed:
+			return job, fmt.Errorf("Bulk write job %s failed", id)
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return job, fmt.Errorf(
+				"Timed out waiting for bulk write job %s in state %s",
+				id,
+				job.Status,
+			)
+		}
+		time.Sleep(interval)
+
+		if interval *= 2; interval > bulkMaxPollInterval {
+			interval = bulkMaxPollInterval
+		}
+	}
+}
+
+// ReadBulkWriteResult will download the result file of the completed bulk write job to a temporary
+// file and return its rows. Each row holds the columns of the uploaded file followed by the STATUS,
+// RECORD_ID and ERRORS columns, embed BulkWriteRowResult in the struct rows are decoded into to
+// retrieve them.
+//
+//	type LeadResult struct {
+//	    crm.BulkWriteRowResult
+//	    Email string `json:"Email"`
+//	}
+//
+// https://www.zoho.com/crm/developer/docs/api/v2/bulk-write/download-result.html
+func (c *API) ReadBulkWriteResult(job BulkWriteJob) (rows *BulkRows, err error) {
+	if job.Result == nil || job.Result.DownloadURL == "" {
+		return nil, fmt.Errorf("Failed to read bulk write result: job %s has no result", job.ID)
+	}
+
+	path, err := c.downloadBulkFile("ReadBulkWriteResult", job.Result.DownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	return openBulkRows(path)
+}
+
+// ParseBulkWriteCallback will decode the notification sent by Zoho to the callback URL of a
+// bulk write job
+func ParseBulkWriteCallback(r *http.Request) (data BulkWriteJob, err error) {
+	defer r.Body.Close()
+
+	job := BulkWriteJob{}
+	if err := json.NewDecoder(r.Body).Decode(&job); err != nil {
+		return BulkWriteJob{}, fmt.Errorf("Failed to decode bulk write callback: %s", err)
+	}
+	if job.ID == "" {
+		job.ID = job.JobID
+	}
+	if job.Status == "" {
+		job.Status = job.State
+	}
+	return job, nil
+}
+
+// BulkWriteRowResult holds the outcome of a row of a bulk write job
+type BulkWriteRowResult struct {
+	Status   string `json:"STATUS"`
+	RecordID string `json:"RECORD_ID"`
+	Errors   string `json:"ERRORS"`
+}
+
+// Failed reports whether the row was not written
+func (r BulkWriteRowResult) Failed() bool {
+	return r.Errors != ""
+}
+
+// BulkUploadResponse is the data returned by UploadBulkWriteFile
+type BulkUploadResponse struct {
+	Status  string `json:"status,omitempty"`
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+	Details struct {
+		FileID      string `json:"file_id,omitempty"`
+		CreatedTime *Time  `json:"created_time,omitempty"`
+	} `json:"details,omitempty"`
+}
+
+// BulkWriteJobRequest is the data provided to CreateBulkWriteJob
+type BulkWriteJobRequest struct {
+	CharacterEncoding string              `json:"character_encoding,omitempty"`
+	Operation         BulkWriteOperation  `json:"operation"`
+	Callback          *BulkCallback       `json:"callback,omitempty"`
+	Resource          []BulkWriteResource `json:"resource"`
+}
+
+// BulkWriteResource describes an uploaded file and how its columns are written to a module.
+// FindBy is the unique field used to match existing records when updating or upserting.
+type BulkWriteResource struct {
+	Type          string              `json:"type,omitempty"` // "data"
+	Module        Module              `json:"module"`
+	FileID        string              `json:"file_id"`
+	IgnoreEmpty   bool                `json:"ignore_empty,omitempty"`
+	FindBy        string              `json:"find_by,omitempty"`
+	FieldMappings []BulkFieldMapping  `json:"field_mappings,omitempty"`
+	Status        BulkJobState        `json:"status,omitempty"`
+	File          *BulkWriteFileState `json:"file,omitempty"`
+}
+
+// BulkFieldMapping maps the column at Index of the uploaded file to the field APIName, lookup
+// fields are matched by the FindBy field of the related module
+type BulkFieldMapping struct {
+	APIName      string `json:"api_name"`
+	Index        *int   `json:"index,omitempty"`
+	Format       string `json:"format,omitempty"`
+	FindBy       string `json:"find_by,omitempty"`
+	DefaultValue *struct {
+		Value interface{} `json:"value,omitempty"`
+	} `json:"default_value,omitempty"`
+}
+
+// BulkWriteFileState is the progress of a file of a bulk write job
+type BulkWriteFileState struct {
+	Status       BulkJobState `json:"status,omitempty"`
+	Name         string       `json:"name,omitempty"`
+	AddedCount   int          `json:"added_count,omitempty"`
+	SkippedCount int          `json:"skipped_count,omitempty"`
+	UpdatedCount int          `json:"updated_count,omitempty"`
+	TotalCount   int          `json:"total_count,omitempty"`
+}
+
+// BulkWriteJob is the data describing a bulk write job
+type BulkWriteJob struct {
+	ID                string              `json:"id,omitempty"`
+	JobID             string              `json:"job_id,omitempty"` // set in callbacks
+	Status            BulkJobState        `json:"status,omitempty"`
+	State             BulkJobState        `json:"state,omitempty"` // set in callbacks
+	Operation         BulkWriteOperation  `json:"operation,omitempty"`
+	CharacterEncoding string              `json:"character_encoding,omitempty"`
+	Resource          []BulkWriteResource `json:"resource,omitempty"`
+	CreatedBy         struct {
+		ID   string `json:"id,omitempty"`
+		Name string `json:"name,omitempty"`
+	} `json:"created_by,omitempty"`
+	CreatedTime *Time `json:"created_time,omitempty"`
+	Result      *struct {
+		DownloadURL string `json:"download_url,omitempty"`
+	} `json:"result,omitempty"`
+
+	// Code and Message describe the error when the job could not be retrieved
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// BulkWriteJobResponse is the data returned by CreateBulkWriteJob
+type BulkWriteJobResponse struct {
+	Status  string       `json:"status,omitempty"`
+	Code    string       `json:"code,omitempty"`
+	Message string       `json:"message,omitempty"`
+	Details BulkWriteJob `json:"details,omitempty"`
+}
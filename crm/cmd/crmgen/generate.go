@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"unicode"
+
+	"github.com/schmorrison/Zoho/crm"
+)
+
+// Generate returns the formatted Go source of the record structs of the modules of the snapshot.
+// When nullable is set the writable fields are generated as *crm.Nullable, so that records can
+// clear fields when they are updated, with the type of their value in a comment.
+func Generate(pkg string, snapshot Snapshot, nullable bool) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by crmgen. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	fmt.Fprintf(&b, "import \"github.com/schmorrison/Zoho/crm\"\n")
+
+	// The record and response structs are reserved first so that the enums and constants of
+	// picklists never take their names
+	modules := snapshot.sortedModules()
+	declared := map[string]bool{}
+	for _, module := range modules {
+		name := identifier(string(module))
+		declared[name+"Record"] = true
+		declared[name+"Response"] = true
+	}
+	for _, module := range modules {
+		generateModule(&b, module, snapshot[module].Fields, declared, nullable)
+	}
+
+	code, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to format generated code: %s", err)
+	}
+	return code, nil
+}
+
+// generateModule writes the record struct, response struct and picklist enums of the module,
+// declared holds the names already declared in the package
+func generateModule(
+	b *bytes.Buffer,
+	module crm.Module,
+	fields []crm.Field,
+	declared map[string]bool,
+	nullable bool,
+) {
+	name := identifier(string(module))
+	names := map[string]bool{"ID": true}
+	var enums bytes.Buffer
+
+	fmt.Fprintf(b, "\n// %sRecord is a record of the %s module\n", name, module)
+	fmt.Fprintf(b, "type %sRecord struct {\n", name)
+	fmt.Fprintf(b, "\tID string `json:\"id,omitempty\"`\n")
+	for _, field := range fields {
+		if field.APIName == "" || field.APIName == "id" {
+			continue
+		}
+
+		fieldName := identifier(field.APIName)
+		for names[fieldName] {
+			fieldName += "_"
+		}
+		names[fieldName] = true
+
+		goType, comment := fieldType(field, field.DataType)
+		switch field.DataType {
+		case "picklist", "multiselectpicklist":
+			prefix := name + fieldName
+			enum := unique(declared, prefix+"Value")
+			writeEnum(&enums, enum, prefix, module, field, declared)
+			goType = enum
+			if field.DataType == "multiselectpicklist" {
+				goType = "[]" + enum
+			}
+		}
+
+		if nullable && !field.IsReadOnly() {
+			valueType := strings.TrimPrefix(goType, "*")
+			if comment != "" {
+				comment = valueType + ", " + comment
+			} else {
+				comment = valueType
+			}
+			goType = "*crm.Nullable"
+		}
+		if comment != "" {
+			comment = " // " + comment
+		}
+		fmt.Fprintf(
+			b,
+			"\t%s %s `json:\"%s,omitempty\"`%s\n",
+			fieldName,
+			goType,
+			field.APIName,
+			comment,
+		)
+	}
+	fmt.Fprintf(b, "}\n")
+
+	fmt.Fprintf(b, "\n// %sResponse is the data returned for the %s module by\n", name, module)
+	fmt.Fprintf(b, "// ListRecords, GetRecord and SearchRecords\n")
+	fmt.Fprintf(b, "type %sResponse struct {\n", name)
+	fmt.Fprintf(b, "\tData []%sRecord `json:\"data,omitempty\"`\n", name)
+	fmt.Fprintf(b, "\tInfo crm.PageInfo `json:\"info,omitempty\"`\n")
+	fmt.Fprintf(b, "}\n")
+
+	b.Write(enums.Bytes())
+}
+
+// fieldType returns the Go type of a field holding values of dataType, and a comment describing
+// the field when the type does not
+func fieldType(field crm.Field, dataType string) (goType string, comment string) {
+	switch dataType {
+	case "text":
+		return "crm.SingleLine", ""
+	case "textarea":
+		return "crm.MultiLine", ""
+	case "email":
+		return "crm.Email", ""
+	case "phone":
+		return "crm.Phone", ""
+	case "website":
+		return "crm.URL", ""
+	case "autonumber":
+		return "crm.AutoNumber", "read only"
+	case "integer":
+		// Numbers are pointers so that 0 is sent when set
+		return "*int", ""
+	case "bigint":
+		// Large numbers may be returned as strings to preserve their precision
+		if field.JSONType == "string" {
+			return "string", ""
+		}
+		return "*crm.Long", ""
+	case "double", "decimal":
+		return "*crm.Decimal", ""
+	case "percent":
+		return "*crm.Percent", ""
+	case "currency":
+		return "*crm.Currency", ""
+	case "boolean":
+		// A pointer so that false is sent when the field is unchecked
+		return "*bool", ""
+	case "date":
+		return "*crm.Date", ""
+	case "datetime":
+		return "*crm.Time", ""
+	case "lookup":
+		if field.Lookup != nil && field.Lookup.Module.APIName != "" {
+			return "*crm.Lookup", "lookup to " + field.Lookup.Module.APIName
+		}
+		return "*crm.Lookup", ""
+	case "ownerlookup", "userlookup":
+		return "*crm.Owner", ""
+	case "multiselectlookup", "multiuserlookup":
+		return "[]crm.Lookup", ""
+	case "formula":
+		if field.Formula != nil && field.Formula.ReturnType != "" {
+			goType, _ := fieldType(field, field.Formula.ReturnType)
+			return goType, "formula, read only"
+		}
+		return "interface{}", "formula, read only"
+	case "picklist", "multiselectpicklist":
+		// Replaced by the enum generated for the field
+		return "", ""
+	}
+
+	switch field.JSONType {
+	case "string":
+		return "string", field.DataType
+	case "integer":
+		return "*int", field.DataType
+	case "double":
+		return "*float64", field.DataType
+	case "boolean":
+		return "*bool", field.DataType
+	case "jsonarray":
+		return "[]map[string]interface{}", field.DataType
+	}
+	return "map[string]interface{}", field.DataType
+}
+
+// writeEnum writes the string type enum for the picklist field with a constant for each of its
+// values, named by prefix followed by the value
+func writeEnum(
+	b *bytes.Buffer,
+	enum string,
+	prefix string,
+	module crm.Module,
+	field crm.Field,
+	declared map[string]bool,
+) {
+	fmt.Fprintf(
+		b,
+		"\n// %s is a value of the %s picklist of the %s module\n",
+		enum,
+		field.APIName,
+		module,
+	)
+	fmt.Fprintf(b, "type %s string\n", enum)
+
+	var constants []string
+	for _, value := range field.PickListValues {
+		if value.ActualValue == "" || value.ActualValue == "-None-" {
+			continue
+		}
+
+		name := unique(declared, prefix+camelCase(value.ActualValue))
+		constants = append(constants, fmt.Sprintf("\t%s %s = %q\n", name, enum, value.ActualValue))
+	}
+	if len(constants) == 0 {
+		return
+	}
+
+	fmt.Fprintf(b, "\n// Values of the %s picklist of the %s module\n", field.APIName, module)
+	fmt.Fprintf(b, "const (\n%s)\n", strings.Join(constants, ""))
+}
+
+// unique returns name, followed by underscores when it is already declared, and declares it
+func unique(declared map[string]bool, name string) string {
+	for declared[name] {
+		name += "_"
+	}
+	declared[name] = true
+	return name
+}
+
+// identifier returns s as an exported Go identifier, such as "LeadStatus" for "Lead_Status"
+func identifier(s string) string {
+	id := camelCase(s)
+	if id == "" {
+		return "Empty"
+	}
+	if unicode.IsDigit([]rune(id)[0]) {
+		id = "N" + id
+	}
+	if strings.HasSuffix(id, "Id") {
+		id = strings.TrimSuffix(id, "Id") + "ID"
+	}
+	return id
+}
+
+// camelCase returns the letters and digits of s with the first letter of each word in upper case
+func camelCase(s string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range s {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
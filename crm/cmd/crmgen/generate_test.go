@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "snapshot.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot := Snapshot{}
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		t.Fatalf("Failed to decode snapshot: %s", err)
+	}
+
+	tests := []struct {
+		golden   string
+		nullable bool
+	}{
+		{golden: "records.golden"},
+		{golden: "records_nullable.golden", nullable: true},
+	}
+
+	for _, tt := range tests {
+		code, err := Generate("crmtypes", snapshot, tt.nullable)
+		if err != nil {
+			t.Fatalf("Generate returned error: %s", err)
+		}
+
+		golden := filepath.Join("testdata", tt.golden)
+		if *update {
+			if err := ioutil.WriteFile(golden, code, 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		want, err := ioutil.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(code, want) {
+			t.Errorf("Generate output does not match %s, run with -update to review:\n%s",
+				golden, code)
+		}
+
+		typeCheck(t, tt.golden, code)
+	}
+}
+
+// typeCheck builds the generated code as a package of this module, so that it is compiled
+// against the current crm package
+func typeCheck(t *testing.T, name string, code []byte) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found, the generated code is not compiled")
+	}
+
+	dir, err := ioutil.TempDir(".", "crmtypes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "records.go"), code, 0644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := exec.Command(goTool, "vet", "./"+dir).CombinedOutput()
+	if err != nil {
+		t.Errorf("code generated for %s does not compile: %s\n%s", name, err, out)
+	}
+}
@@ -0,0 +1,162 @@
+// Command crmgen generates Go structs for the records of Zoho CRM modules from the field metadata
+// of an organization, including its custom fields and picklist values.
+//
+// The metadata is either retrieved from Zoho, with the tokens saved by the zoho package or a
+// refresh token, or read from a snapshot saved by a previous run for offline use:
+//
+//	crmgen -modules Leads,Deals -refresh-token $TOKEN -client-id $ID -client-secret $SECRET \
+//	    -save-snapshot crm.json -package crmtypes -o crmtypes/records.go
+//	crmgen -snapshot crm.json -package crmtypes -o crmtypes/records.go
+//
+// For each module a <Module>Record struct is generated along with a <Module>Response holding the
+// records returned by ListRecords, GetRecord and SearchRecords. Records are inserted by providing
+// a slice of <Module>Record as the Data of crm.InsertRecordsData. Picklist fields are typed
+// <Module><Field>Value, with a constant for each of their values. Fields are omitted from requests
+// when they are unset, so numbers, booleans, dates and lookups are pointers which can be set to
+// their zero value.
+//
+// With -nullable the writable fields are generated as *crm.Nullable instead, with the type of
+// their value in a comment, so that records can clear fields when they are updated.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	zoho "github.com/schmorrison/Zoho"
+	"github.com/schmorrison/Zoho/crm"
+)
+
+func main() {
+	var (
+		modules      = flag.String("modules", "", "API names of the modules, comma separated")
+		snapshot     = flag.String("snapshot", "", "read the metadata from this file")
+		saveSnapshot = flag.String("save-snapshot", "", "save the metadata to this file")
+		pkg          = flag.String("package", "crmtypes", "package of the generated code")
+		output       = flag.String("o", "", "write the generated code to this file")
+		nullable     = flag.Bool("nullable", false, "generate writable fields as *crm.Nullable")
+		tokensFile   = flag.String("tokens", "./.tokens.zoho", "tokens saved by the zoho package")
+		tld          = flag.String("tld", "com", "TLD of the Zoho data center")
+		clientID     = flag.String("client-id", "", "OAuth client ID")
+		clientSecret = flag.String("client-secret", "", "OAuth client secret")
+		refreshToken = flag.String("refresh-token", "", "OAuth refresh token")
+	)
+	flag.Parse()
+
+	var names []crm.Module
+	for _, name := range strings.Split(*modules, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, crm.Module(name))
+		}
+	}
+
+	var (
+		metadata Snapshot
+		err      error
+	)
+	if *snapshot != "" {
+		metadata, err = readSnapshot(*snapshot, names)
+	} else {
+		if len(names) == 0 {
+			log.Fatal("Failed, provide the modules to generate with -modules or a -snapshot")
+		}
+
+		z := zoho.New()
+		z.SetZohoTLD(*tld)
+		z.SetTokensFile(*tokensFile)
+		if *refreshToken != "" {
+			z.SetClientID(*clientID)
+			z.SetClientSecret(*clientSecret)
+			z.SetRefreshToken(*refreshToken)
+			if err := z.RefreshTokenRequest(); err != nil {
+				log.Fatal(err)
+			}
+		}
+		metadata, err = fetchSnapshot(crm.New(z), names)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *saveSnapshot != "" {
+		b, err := json.MarshalIndent(metadata, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to encode snapshot: %s", err)
+		}
+		if err := ioutil.WriteFile(*saveSnapshot, b, 0644); err != nil {
+			log.Fatalf("Failed to save snapshot: %s", err)
+		}
+	}
+
+	code, err := Generate(*pkg, metadata, *nullable)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *output == "" {
+		os.Stdout.Write(code)
+		return
+	}
+	if err := ioutil.WriteFile(*output, code, 0644); err != nil {
+		log.Fatalf("Failed to write %s: %s", *output, err)
+	}
+}
+
+// Snapshot is the field metadata of modules by module API name, as saved with -save-snapshot
+type Snapshot map[crm.Module]crm.FieldsResponse
+
+// fetchSnapshot retrieves the field metadata of the modules from Zoho
+func fetchSnapshot(api *crm.API, modules []crm.Module) (Snapshot, error) {
+	snapshot := Snapshot{}
+	for _, module := range modules {
+		fields, err := api.GetFields(module)
+		if err != nil {
+			return nil, err
+		}
+		snapshot[module] = fields
+	}
+	return snapshot, nil
+}
+
+// readSnapshot reads the field metadata of the modules from the snapshot file, or of all the
+// modules of the snapshot when none are provided
+func readSnapshot(path string, modules []crm.Module) (Snapshot, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read snapshot: %s", err)
+	}
+
+	snapshot := Snapshot{}
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return nil, fmt.Errorf("Failed to decode snapshot %s: %s", path, err)
+	}
+	if len(modules) == 0 {
+		return snapshot, nil
+	}
+
+	selected := Snapshot{}
+	for _, module := range modules {
+		fields, ok := snapshot[module]
+		if !ok {
+			return nil, fmt.Errorf("Failed, module %s is not in snapshot %s", module, path)
+		}
+		selected[module] = fields
+	}
+	return selected, nil
+}
+
+// sortedModules returns the modules of the snapshot in a stable order
+func (s Snapshot) sortedModules() []crm.Module {
+	modules := make([]crm.Module, 0, len(s))
+	for module := range s {
+		modules = append(modules, module)
+	}
+	sort.Slice(modules, func(i, j int) bool { return modules[i] < modules[j] })
+	return modules
+}
@@ -0,0 +1,346 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// SortOrder is the direction records are sorted in
+type SortOrder string
+
+// Proper names for sort orders
+const (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+)
+
+// COQL limits a single query to 200 records
+const coqlMaxLimit = 200
+
+// QueryBuilder builds a COQL select query, values are provided as arguments for '?' placeholders
+// and are escaped when the query is built.
+//
+//	q := crm.Select("Last_Name", "Account_Name.Account_Name").
+//	    From(crm.ContactsModule).
+//	    Where("Last_Name = ? and Lead_Source in ?", "O'Neil", []string{"Web", "Referral"}).
+//	    OrderBy("Created_Time", crm.Descending).
+//	    Limit(500)
+//
+// Fields of lookup records are selected and filtered by joining the lookup field and the field
+// of the related module with a dot, such as "Account_Name.Parent_Account.Account_Name".
+type QueryBuilder struct {
+	fields  []string
+	module  Module
+	where   []string
+	orderBy []string
+	limit   int
+	offset  int
+	err     error
+}
+
+// Select returns a QueryBuilder selecting the fields
+func Select(fields ...string) *QueryBuilder {
+	return &QueryBuilder{fields: fields}
+}
+
+// From sets the module the records are selected from
+func (q *QueryBuilder) From(module Module) *QueryBuilder {
+	q.module = module
+	return q
+}
+
+// Where adds a condition the records must match, each '?' of the condition is replaced by the
+// next argument. Conditions of successive calls are combined with 'and', use 'or' and parentheses
+// within a condition to group alternatives.
+func (q *QueryBuilder) Where(condition string, args ...interface{}) *QueryBuilder {
+	expanded, err := expandCOQL(condition, args)
+	if err != nil && q.err == nil {
+		q.err = err
+	}
+	q.where = append(q.where, "("+expanded+")")
+	return q
+}
+
+// OrderBy adds a field the records are sorted by
+func (q *QueryBuilder) OrderBy(field string, order SortOrder) *QueryBuilder {
+	q.orderBy = append(q.orderBy, strings.TrimSpace(field+" "+string(order)))
+	return q
+}
+
+// Limit sets the maximum number of records returned, QueryAll retrieves them in several pages
+// when more than 200 are requested
+func (q *QueryBuilder) Limit(limit int) *QueryBuilder {
+	q.limit = limit
+	return q
+}
+
+// Offset sets the number of matching records skipped
+func (q *QueryBuilder) Offset(offset int) *QueryBuilder {
+	q.offset = offset
+	return q
+}
+
+// Build returns the COQL query, or the first error met while building it. COQL rejects queries
+// without a where clause, so 'where id is not null' is added when no condition is provided.
+func (q *QueryBuilder) Build() (string, error) {
+	return q.page(q.offset, q.limit)
+}
+
+// String returns the COQL query
+func (q *QueryBuilder) String() string {
+	query, _ := q.Build()
+	return query
+}
+
+// page returns the COQL query for count records starting at offset
+func (q *QueryBuilder) page(offset, count int) (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+	if len(q.fields) == 0 {
+		return "", fmt.Errorf("Failed to build COQL query: no fields selected")
+	}
+	if q.module == "" {
+		return "", fmt.Errorf("Failed to build COQL query: no module provided")
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "select %s from %s", strings.Join(q.fields, ", "), q.module)
+
+	// COQL rejects queries without a where clause
+	if len(q.where) == 0 {
+		b.WriteString(" where id is not null")
+	} else {
+		fmt.Fprintf(&b, " where %s", strings.Join(q.where, " and "))
+	}
+
+	if len(q.orderBy) > 0 {
+		fmt.Fprintf(&b, " order by %s", strings.Join(q.orderBy, ", "))
+	}
+
+	if count <= 0 || count > coqlMaxLimit {
+		count = coqlMaxLimit
+	}
+	fmt.Fprintf(&b, " limit %d, %d", offset, count)
+	return b.String(), nil
+}
+
+// Query will execute the COQL query and store the response in the provided response,
+// the records are found in its 'data' field. A query rejected by Zoho returns the error code.
+// https://www.zoho.com/crm/developer/docs/api/v2/COQL-Overview.html
+func (c *API) Query(response interface{}, query string) (data interface{}, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "coql",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/coql", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &json.RawMessage{},
+		RequestBody: &QueryData{
+			SelectQuery: query,
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to execute COQL query: %s", err)
+	}
+
+	body, ok := endpoint.ResponseData.(*json.RawMessage)
+	if !ok {
+		return nil, fmt.Errorf("Data retrieved was not 'json.RawMessage'")
+	}
+
+	// A query matching no records returns no content
+	if len(*body) == 0 {
+		return response, nil
+	}
+
+	// Rejected queries are reported with a code instead of the records
+	e := Error{}
+	if err := json.Unmarshal(*body, &e); err == nil && e.Code != "" {
+		return nil, fmt.Errorf("Failed to execute COQL query: %s: %s", e.Code, e.Message)
+	}
+
+	if err := json.Unmarshal(*body, response); err != nil {
+		return nil, fmt.Errorf("Failed to decode COQL response: %s", err)
+	}
+	return response, nil
+}
+
+// QueryAll will execute the query built by q page after page, appending the records to the slice
+// pointed to by records, until its limit is reached or no more records match. The records are
+// sorted by id when q has no order so that the pages do not overlap.
+func (c *API) QueryAll(records interface{}, q *QueryBuilder) (err error) {
+	slice := reflect.ValueOf(records)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("Failed, you must pass a pointer to a slice in the records parameter")
+	}
+	slice = slice.Elem()
+
+	if len(q.orderBy) == 0 {
+		ordered := *q
+		ordered.orderBy = []string{"id"}
+		q = &ordered
+	}
+
+	offset, retrieved := q.offset, 0
+	for q.limit <= 0 || retrieved < q.limit {
+		count := coqlMaxLimit
+		if q.limit > 0 && q.limit-retrieved < count {
+			count = q.limit - retrieved
+		}
+
+		query, err := q.page(offset, count)
+		if err != nil {
+			return err
+		}
+
+		v, err := c.Query(&QueryResponse{}, query)
+		if err != nil {
+			return err
+		}
+		resp, ok := v.(*QueryResponse)
+		if !ok {
+			return fmt.Errorf("Data retrieved was not 'QueryResponse'")
+		}
+
+		// A query matching no records returns no content
+		if len(resp.Data) == 0 {
+			return nil
+		}
+
+		page := reflect.New(slice.Type())
+		if err := json.Unmarshal(resp.Data, page.Interface()); err != nil {
+			return fmt.Errorf("Failed to decode COQL records: %s", err)
+		}
+		slice.Set(reflect.AppendSlice(slice, page.Elem()))
+
+		retrieved += page.Elem().Len()
+		offset += page.Elem().Len()
+		if !resp.Info.MoreRecords || page.Elem().Len() == 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+// QueryData is the data provided to Query
+type QueryData struct {
+	SelectQuery string `json:"select_query"`
+}
+
+// QueryResponse is the data returned by Query when the records are not decoded into a specific type
+type QueryResponse struct {
+	Data json.RawMessage `json:"data,omitempty"`
+	Info PageInfo        `json:"info,omitempty"`
+}
+
+// expandCOQL replaces the '?' placeholders of condition which are not within a quoted string by the
+// escaped args
+func expandCOQL(condition string, args []interface{}) (string, error) {
+	var (
+		b      strings.Builder
+		quoted bool
+		next   int
+	)
+	for i := 0; i < len(condition); i++ {
+		ch := condition[i]
+		switch {
+		case ch == '\\' && quoted && i+1 < len(condition):
+			b.WriteByte(ch)
+			i++
+			ch = condition[i]
+		case ch == '\'':
+			quoted = !quoted
+		case ch == '?' && !quoted:
+			if next >= len(args) {
+				return condition, fmt.Errorf(
+					"Failed to build COQL query: missing argument for placeholder %d in %q",
+					next+1,
+					condition,
+				)
+			}
+			value, err := coqlValue(args[next])
+			if err != nil {
+				return condition, err
+			}
+			b.WriteString(value)
+			next++
+			continue
+		}
+		b.WriteByte(ch)
+	}
+
+	if next != len(args) {
+		return condition, fmt.Errorf(
+			"Failed to build COQL query: %d arguments provided for %d placeholders in %q",
+			len(args),
+			next,
+			condition,
+		)
+	}
+	return b.String(), nil
+}
+
+// coqlValue returns v formatted as a COQL literal
+func coqlValue(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return quoteCOQL(value), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	case time.Time:
+		return quoteCOQL(zoho.Time(value).String()), nil
+	case zoho.Time:
+		return quoteCOQL(value.String()), nil
+	case zoho.Date:
+		return quoteCOQL(value.String()), nil
+	case zoho.Money:
+		return value.String(), nil
+	case fmt.Stringer:
+		return quoteCOQL(value.String()), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, 64), nil
+	case reflect.String:
+		return quoteCOQL(rv.String()), nil
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return "null", nil
+		}
+		return coqlValue(rv.Elem().Interface())
+	case reflect.Slice, reflect.Array:
+		if rv.Len() == 0 {
+			return "", fmt.Errorf("Failed to build COQL query: empty list of values for 'in'")
+		}
+		values := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			value, err := coqlValue(rv.Index(i).Interface())
+			if err != nil {
+				return "", err
+			}
+			values = append(values, value)
+		}
+		return "(" + strings.Join(values, ", ") + ")", nil
+	}
+	return "", fmt.Errorf("Failed to build COQL query: unsupported value %v of type %T", v, v)
+}
+
+// quoteCOQL returns s as a quoted COQL string
+func quoteCOQL(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
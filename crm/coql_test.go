@@ -0,0 +1,193 @@
+package crm
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	zoho "github.com/schmorrison/Zoho"
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestQueryBuilderBuild(t *testing.T) {
+	ist := time.FixedZone("", 5*3600+1800)
+	tests := []struct {
+		name  string
+		query *QueryBuilder
+		want  string
+	}{
+		{
+			name:  "default where",
+			query: Select("Last_Name").From(ContactsModule),
+			want:  "select Last_Name from Contacts where id is not null limit 0, 200",
+		},
+		{
+			name: "placeholders",
+			query: Select("Last_Name", "Account_Name.Account_Name").
+				From(ContactsModule).
+				Where("Last_Name = ? and Lead_Source in ?", `O'Neil\`, []string{"Web", "Referral"}).
+				Where("Age > ? or Active = ?", 30, true).
+				OrderBy("Created_Time", Descending).
+				Limit(50).
+				Offset(10),
+			want: `select Last_Name, Account_Name.Account_Name from Contacts ` +
+				`where (Last_Name = 'O\'Neil\\' and Lead_Source in ('Web', 'Referral')) ` +
+				`and (Age > 30 or Active = true) order by Created_Time desc limit 10, 50`,
+		},
+		{
+			name: "quoted placeholder",
+			query: Select("id").
+				From(LeadsModule).
+				Where("Company = 'Who?' and Email = ?", "a@b.com"),
+			want: `select id from Leads where (Company = 'Who?' and Email = 'a@b.com') ` +
+				`limit 0, 200`,
+		},
+		{
+			name: "time",
+			query: Select("id").
+				From(LeadsModule).
+				Where("Created_Time > ?", time.Date(2019, 5, 9, 15, 19, 13, 0, ist)).
+				Where("Modified_Time < ?", zoho.Time(time.Date(2019, 5, 9, 9, 0, 0, 0, time.UTC))),
+			want: `select id from Leads where (Created_Time > '2019-05-09T15:19:13+05:30') ` +
+				`and (Modified_Time < '2019-05-09T09:00:00+00:00') limit 0, 200`,
+		},
+		{
+			name: "values",
+			query: Select("id").
+				From(DealsModule).
+				Where("Amount >= ? and Closing_Date = ? and Owner = ?",
+					zoho.NewMoney(150050, 2, "USD"),
+					zoho.Date(time.Date(2019, 5, 9, 0, 0, 0, 0, time.UTC)),
+					nil,
+				).
+				Limit(1000),
+			want: `select id from Deals where (Amount >= 1500.50 and Closing_Date = '2019-05-09' ` +
+				`and Owner = null) limit 0, 200`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.query.Build()
+		if err != nil {
+			t.Errorf("%s: Build returned error: %s", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: Build =\n%s\nwant\n%s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestQueryBuilderBuildError(t *testing.T) {
+	tests := []struct {
+		name  string
+		query *QueryBuilder
+	}{
+		{name: "no fields", query: Select().From(LeadsModule)},
+		{name: "no module", query: Select("id")},
+		{
+			name:  "missing argument",
+			query: Select("id").From(LeadsModule).Where("a = ? and b = ?", 1),
+		},
+		{name: "extra argument", query: Select("id").From(LeadsModule).Where("a = ?", 1, 2)},
+		{name: "empty in", query: Select("id").From(LeadsModule).Where("a in ?", []string{})},
+		{name: "empty not in", query: Select("id").From(LeadsModule).Where("a not in ?", []int{})},
+		{name: "unsupported", query: Select("id").From(LeadsModule).Where("a = ?", struct{}{})},
+	}
+
+	for _, tt := range tests {
+		if got, err := tt.query.Build(); err == nil {
+			t.Errorf("%s: Build = %s, want error", tt.name, got)
+		}
+	}
+}
+
+func TestQueryAll(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var queries []string
+	s.Handle(http.MethodPost, "/crm/v2/coql", func(w http.ResponseWriter, r *http.Request) {
+		data := QueryData{}
+		zohotest.DecodeJSON(t, r, &data)
+		queries = append(queries, data.SelectQuery)
+
+		switch len(queries) {
+		case 1:
+			w.Write([]byte(`{"data":[{"id":"1"},{"id":"2"}],"info":{"more_records":true}}`))
+		case 2:
+			w.Write([]byte(`{"data":[{"id":"3"}],"info":{"more_records":false}}`))
+		default:
+			t.Errorf("unexpected query %s", data.SelectQuery)
+		}
+	})
+	api := New(s.Client())
+
+	var records []struct {
+		ID string `json:"id"`
+	}
+	if err := api.QueryAll(&records, Select("id").From(LeadsModule).Limit(250)); err != nil {
+		t.Fatalf("QueryAll returned error: %s", err)
+	}
+
+	want := []string{
+		"select id from Leads where id is not null order by id limit 0, 200",
+		"select id from Leads where id is not null order by id limit 2, 200",
+	}
+	if len(queries) != len(want) || queries[0] != want[0] || queries[1] != want[1] {
+		t.Errorf("queries = %q, want %q", queries, want)
+	}
+	if len(records) != 3 || records[2].ID != "3" {
+		t.Errorf("records = %+v, want 3 records", records)
+	}
+}
+
+func TestQueryAllOrdered(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var query string
+	s.Handle(http.MethodPost, "/crm/v2/coql", func(w http.ResponseWriter, r *http.Request) {
+		data := QueryData{}
+		zohotest.DecodeJSON(t, r, &data)
+		query = data.SelectQuery
+		w.Write([]byte(`{"data":[{"id":"1"}],"info":{"more_records":false}}`))
+	})
+
+	var records []struct {
+		ID string `json:"id"`
+	}
+	q := Select("id").From(LeadsModule).OrderBy("Created_Time", Descending)
+	if err := New(s.Client()).QueryAll(&records, q); err != nil {
+		t.Fatalf("QueryAll returned error: %s", err)
+	}
+
+	want := "select id from Leads where id is not null order by Created_Time desc limit 0, 200"
+	if query != want {
+		t.Errorf("query = %s, want %s", query, want)
+	}
+}
+
+func TestQueryError(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	s.Handle(http.MethodPost, "/crm/v2/coql", zohotest.Reply(`{
+		"code": "SYNTAX_ERROR",
+		"details": {"clause": "where"},
+		"message": "error occured while parsing the query",
+		"status": "error"
+	}`))
+	api := New(s.Client())
+
+	if _, err := api.Query(&QueryResponse{}, "select id from Leads"); err == nil {
+		t.Errorf("Query of a rejected query returned no error")
+	}
+
+	var records []struct {
+		ID string `json:"id"`
+	}
+	if err := api.QueryAll(&records, Select("id").From(LeadsModule)); err == nil {
+		t.Errorf("QueryAll of a rejected query returned no error")
+	}
+}
@@ -0,0 +1,116 @@
+package crm
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// GetCustomViews will return the custom views of the module. It requires the
+// zoho.BuildScope(zoho.Crm, zoho.SettingsScope, zoho.CustomViews, zoho.Read) scope.
+// https://www.zoho.com/crm/developer/docs/api/v2/custom-view-meta.html
+func (c *API) GetCustomViews(module Module) (data CustomViewsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "custom_views",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/custom_views",
+			c.ZohoTLD,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &CustomViewsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CustomViewsResponse{}, fmt.Errorf(
+			"Failed to retrieve custom views of %s: %s",
+			module,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomViewsResponse); ok {
+		return *v, nil
+	}
+
+	return CustomViewsResponse{}, fmt.Errorf("Data retrieved was not 'CustomViewsResponse'")
+}
+
+// GetCustomView will return the custom view of the module specified by id, including its criteria
+// https://www.zoho.com/crm/developer/docs/api/v2/custom-view-meta.html
+func (c *API) GetCustomView(module Module, id string) (data CustomViewsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "custom_views",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/custom_views/%s",
+			c.ZohoTLD,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &CustomViewsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return CustomViewsResponse{}, fmt.Errorf(
+			"Failed to retrieve custom view (%s) of %s: %s",
+			id,
+			module,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*CustomViewsResponse); ok {
+		return *v, nil
+	}
+
+	return CustomViewsResponse{}, fmt.Errorf("Data retrieved was not 'CustomViewsResponse'")
+}
+
+// CustomViewsResponse is the data returned by GetCustomViews and GetCustomView
+type CustomViewsResponse struct {
+	CustomViews []CustomView `json:"custom_views,omitempty"`
+	Info        struct {
+		PerPage     int               `json:"per_page,omitempty"`
+		Count       int               `json:"count,omitempty"`
+		Page        int               `json:"page,omitempty"`
+		MoreRecords bool              `json:"more_records,omitempty"`
+		Default     string            `json:"default,omitempty"`
+		Translation map[string]string `json:"translation,omitempty"`
+	} `json:"info,omitempty"`
+}
+
+// CustomView is the metadata of a custom view of a module, its ID is used as the 'cvid' parameter
+// of ListRecords and bulk read jobs
+type CustomView struct {
+	ID            string              `json:"id,omitempty"`
+	Name          string              `json:"name,omitempty"`
+	SystemName    string              `json:"system_name,omitempty"`
+	DisplayValue  string              `json:"display_value,omitempty"`
+	Category      string              `json:"category,omitempty"`
+	SharedType    string              `json:"shared_type,omitempty"`
+	Default       bool                `json:"default,omitempty"`
+	SystemDefined bool                `json:"system_defined,omitempty"`
+	Favorite      *int                `json:"favorite,omitempty"`
+	Offline       bool                `json:"offline,omitempty"`
+	SortBy        string              `json:"sort_by,omitempty"`
+	SortOrder     string              `json:"sort_order,omitempty"`
+	Fields        []APIReference      `json:"fields,omitempty"`
+	Criteria      *CustomViewCriteria `json:"criteria,omitempty"`
+}
+
+// CustomViewCriteria filters the records of a custom view, either comparing a field with a value
+// or combining a group of criteria with GroupOperator ("and" or "or")
+type CustomViewCriteria struct {
+	Field         *APIReference        `json:"field,omitempty"`
+	Comparator    string               `json:"comparator,omitempty"`
+	Value         interface{}          `json:"value,omitempty"`
+	GroupOperator string               `json:"group_operator,omitempty"`
+	Group         []CustomViewCriteria `json:"group,omitempty"`
+}
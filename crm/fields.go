@@ -0,0 +1,173 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// GetFields will return the metadata of the fields of the module, including custom fields and
+// picklist values. It requires the zoho.BuildScope(zoho.Crm, zoho.SettingsScope, zoho.Fields,
+// zoho.Read) scope.
+// https://www.zoho.com/crm/developer/docs/api/v2/field-meta.html
+func (c *API) GetFields(module Module) (data FieldsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "fields",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/fields", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &FieldsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return FieldsResponse{}, fmt.Errorf("Failed to retrieve fields of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*FieldsResponse); ok {
+		return *v, nil
+	}
+
+	return FieldsResponse{}, fmt.Errorf("Data retrieved was not 'FieldsResponse'")
+}
+
+// GetField will return the metadata of the field of the module specified by id
+// https://www.zoho.com/crm/developer/docs/api/v2/field-meta.html
+func (c *API) GetField(module Module, id string) (data FieldsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "fields",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/fields/%s",
+			c.ZohoTLD,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &FieldsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return FieldsResponse{}, fmt.Errorf(
+			"Failed to retrieve field (%s) of %s: %s",
+			id,
+			module,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*FieldsResponse); ok {
+		return *v, nil
+	}
+
+	return FieldsResponse{}, fmt.Errorf("Data retrieved was not 'FieldsResponse'")
+}
+
+// FieldsResponse is the data returned by GetFields and GetField
+type FieldsResponse struct {
+	Fields []Field `json:"fields,omitempty"`
+}
+
+// Field is the metadata of a field of a module, DataType is one of "text", "textarea", "email",
+// "phone", "website", "picklist", "multiselectpicklist", "integer", "bigint", "double",
+// "currency", "percent", "boolean", "date", "datetime", "lookup", "ownerlookup", "autonumber",
+// "formula", "subform", etc.
+type Field struct {
+	ID                    string          `json:"id,omitempty"`
+	APIName               string          `json:"api_name,omitempty"`
+	FieldLabel            string          `json:"field_label,omitempty"`
+	DisplayLabel          string          `json:"display_label,omitempty"`
+	DataType              string          `json:"data_type,omitempty"`
+	JSONType              string          `json:"json_type,omitempty"`
+	Length                int             `json:"length,omitempty"`
+	DecimalPlace          *int            `json:"decimal_place,omitempty"`
+	ReadOnly              bool            `json:"read_only,omitempty"`
+	FieldReadOnly         bool            `json:"field_read_only,omitempty"`
+	SystemMandatory       bool            `json:"system_mandatory,omitempty"`
+	CustomField           bool            `json:"custom_field,omitempty"`
+	Visible               bool            `json:"visible,omitempty"`
+	Sortable              bool            `json:"sortable,omitempty"`
+	Webhook               bool            `json:"webhook,omitempty"`
+	BusinessCardSupported bool            `json:"businesscard_supported,omitempty"`
+	CreatedSource         string          `json:"created_source,omitempty"`
+	DefaultValue          interface{}     `json:"default_value,omitempty"`
+	Unique                json.RawMessage `json:"unique,omitempty"`
+	PickListValues        []PickListValue `json:"pick_list_values,omitempty"`
+	Lookup                *FieldLookup    `json:"lookup,omitempty"`
+	Currency              *struct {
+		RoundingOption string `json:"rounding_option,omitempty"`
+		Precision      int    `json:"precision,omitempty"`
+	} `json:"currency,omitempty"`
+	Formula *struct {
+		ReturnType string `json:"return_type,omitempty"`
+		Expression string `json:"expression,omitempty"`
+	} `json:"formula,omitempty"`
+	AutoNumber *struct {
+		Prefix      string `json:"prefix,omitempty"`
+		Suffix      string `json:"suffix,omitempty"`
+		StartNumber int    `json:"start_number,omitempty"`
+	} `json:"auto_number,omitempty"`
+	Subform *APIReference `json:"subform,omitempty"`
+}
+
+// IsReadOnly reports whether the field cannot be written through the API
+func (f Field) IsReadOnly() bool {
+	return f.ReadOnly || f.FieldReadOnly || f.DataType == "autonumber" || f.DataType == "formula"
+}
+
+// HasPickListValue reports whether value is one of the values of the picklist field
+func (f Field) HasPickListValue(value string) bool {
+	for _, v := range f.PickListValues {
+		if v.ActualValue == value || v.DisplayValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+// PickListValue is a value of a picklist field
+type PickListValue struct {
+	ID             string `json:"id,omitempty"`
+	DisplayValue   string `json:"display_value,omitempty"`
+	ActualValue    string `json:"actual_value,omitempty"`
+	ReferenceValue string `json:"reference_value,omitempty"`
+	SequenceNumber int    `json:"sequence_number,omitempty"`
+	Type           string `json:"type,omitempty"`
+}
+
+// FieldLookup describes the module referenced by a lookup field
+type FieldLookup struct {
+	ID           string       `json:"id,omitempty"`
+	APIName      string       `json:"api_name,omitempty"`
+	DisplayLabel string       `json:"display_label,omitempty"`
+	Module       APIReference `json:"module,omitempty"`
+}
+
+// APIReference references a module or field of the metadata, Zoho returns it either as the API
+// name or as an object holding the API name and ID
+type APIReference struct {
+	APIName string `json:"api_name,omitempty"`
+	ID      string `json:"id,omitempty"`
+}
+
+// UnmarshalJSON is the json unmarshalling function for APIReference internal type
+func (r *APIReference) UnmarshalJSON(b []byte) error {
+	var name string
+	if err := json.Unmarshal(b, &name); err == nil {
+		*r = APIReference{APIName: name}
+		return nil
+	}
+
+	type reference APIReference
+	ref := reference{}
+	if err := json.Unmarshal(b, &ref); err != nil {
+		return err
+	}
+	*r = APIReference(ref)
+	return nil
+}
@@ -0,0 +1,111 @@
+package crm
+
+import (
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// GetLayouts will return the layouts of the module with their sections and fields. It requires
+// the zoho.BuildScope(zoho.Crm, zoho.SettingsScope, zoho.Layouts, zoho.Read) scope.
+// https://www.zoho.com/crm/developer/docs/api/v2/layouts-meta.html
+func (c *API) GetLayouts(module Module) (data LayoutsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "layouts",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/layouts", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &LayoutsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return LayoutsResponse{}, fmt.Errorf("Failed to retrieve layouts of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*LayoutsResponse); ok {
+		return *v, nil
+	}
+
+	return LayoutsResponse{}, fmt.Errorf("Data retrieved was not 'LayoutsResponse'")
+}
+
+// GetLayout will return the layout of the module specified by id
+// https://www.zoho.com/crm/developer/docs/api/v2/layouts-meta.html
+func (c *API) GetLayout(module Module, id string) (data LayoutsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "layouts",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/layouts/%s",
+			c.ZohoTLD,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &LayoutsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return LayoutsResponse{}, fmt.Errorf(
+			"Failed to retrieve layout (%s) of %s: %s",
+			id,
+			module,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*LayoutsResponse); ok {
+		return *v, nil
+	}
+
+	return LayoutsResponse{}, fmt.Errorf("Data retrieved was not 'LayoutsResponse'")
+}
+
+// LayoutsResponse is the data returned by GetLayouts and GetLayout
+type LayoutsResponse struct {
+	Layouts []LayoutMetadata `json:"layouts,omitempty"`
+}
+
+// LayoutMetadata is the metadata of a layout of a module
+type LayoutMetadata struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Visible      bool   `json:"visible,omitempty"`
+	Status       int    `json:"status,omitempty"`
+	CreatedTime  *Time  `json:"created_time,omitempty"`
+	ModifiedTime *Time  `json:"modified_time,omitempty"`
+	CreatedBy    *Owner `json:"created_by,omitempty"`
+	ModifiedBy   *Owner `json:"modified_by,omitempty"`
+	Profiles     []struct {
+		ID      string `json:"id,omitempty"`
+		Name    string `json:"name,omitempty"`
+		Default bool   `json:"default,omitempty"`
+	} `json:"profiles,omitempty"`
+	Sections []LayoutSection `json:"sections,omitempty"`
+}
+
+// Fields returns the fields of all the sections of the layout
+func (l LayoutMetadata) Fields() []Field {
+	var fields []Field
+	for _, section := range l.Sections {
+		fields = append(fields, section.Fields...)
+	}
+	return fields
+}
+
+// LayoutSection is a section of a layout and the fields it displays
+type LayoutSection struct {
+	APIName          string  `json:"api_name,omitempty"`
+	Name             string  `json:"name,omitempty"`
+	DisplayLabel     string  `json:"display_label,omitempty"`
+	SequenceNumber   int     `json:"sequence_number,omitempty"`
+	ColumnCount      int     `json:"column_count,omitempty"`
+	IsSubformSection bool    `json:"isSubformSection,omitempty"`
+	GeneratedType    string  `json:"generated_type,omitempty"`
+	Fields           []Field `json:"fields,omitempty"`
+}
@@ -0,0 +1,248 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MetadataCache keeps the fields, layouts, custom views and related lists of modules in memory,
+// retrieving them again once they are older than its TTL. It is safe for concurrent use.
+//
+//	meta := crm.NewMetadataCache(c, time.Hour)
+//	types, err := meta.FieldTypes(crm.DealsModule)
+//	if err != nil {
+//	    return err
+//	}
+//	err = criteria.Validate(types)
+type MetadataCache struct {
+	api *API
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]metadataEntry
+}
+
+type metadataEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// NewMetadataCache returns a MetadataCache retrieving metadata with api and keeping it for ttl
+func NewMetadataCache(api *API, ttl time.Duration) *MetadataCache {
+	return &MetadataCache{
+		api:     api,
+		ttl:     ttl,
+		entries: map[string]metadataEntry{},
+	}
+}
+
+// get returns the cached value for key, calling fetch to retrieve it when missing or expired
+func (m *MetadataCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	m.mu.Lock()
+	entry, ok := m.entries[key]
+	m.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value, nil
+	}
+
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	m.entries[key] = metadataEntry{value: value, expires: time.Now().Add(m.ttl)}
+	m.mu.Unlock()
+	return value, nil
+}
+
+// Invalidate removes the cached metadata of the modules, or of all modules when none are provided
+func (m *MetadataCache) Invalidate(modules ...Module) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(modules) == 0 {
+		m.entries = map[string]metadataEntry{}
+		return
+	}
+	for key := range m.entries {
+		for _, module := range modules {
+			if strings.HasSuffix(key, ":"+string(module)) {
+				delete(m.entries, key)
+			}
+		}
+	}
+}
+
+// Fields returns the fields of the module
+func (m *MetadataCache) Fields(module Module) ([]Field, error) {
+	v, err := m.get("fields:"+string(module), func() (interface{}, error) {
+		resp, err := m.api.GetFields(module)
+		return resp.Fields, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]Field), nil
+}
+
+// Field returns the field of the module with the API name, and false when it does not exist
+func (m *MetadataCache) Field(module Module, apiName string) (Field, bool, error) {
+	fields, err := m.Fields(module)
+	if err != nil {
+		return Field{}, false, err
+	}
+	for _, field := range fields {
+		if field.APIName == apiName {
+			return field, true, nil
+		}
+	}
+	return Field{}, false, nil
+}
+
+// CustomFields returns the fields of the module created by the organization
+func (m *MetadataCache) CustomFields(module Module) ([]Field, error) {
+	fields, err := m.Fields(module)
+	if err != nil {
+		return nil, err
+	}
+
+	var custom []Field
+	for _, field := range fields {
+		if field.CustomField {
+			custom = append(custom, field)
+		}
+	}
+	return custom, nil
+}
+
+// FieldTypes returns the data types of the fields of the module by API name, as expected by
+// zoho.Criteria.Validate
+func (m *MetadataCache) FieldTypes(module Module) (map[string]string, error) {
+	fields, err := m.Fields(module)
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]string, len(fields))
+	for _, field := range fields {
+		types[field.APIName] = field.DataType
+	}
+	return types, nil
+}
+
+// Layouts returns the layouts of the module
+func (m *MetadataCache) Layouts(module Module) ([]LayoutMetadata, error) {
+	v, err := m.get("layouts:"+string(module), func() (interface{}, error) {
+		resp, err := m.api.GetLayouts(module)
+		return resp.Layouts, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]LayoutMetadata), nil
+}
+
+// CustomViews returns the custom views of the module
+func (m *MetadataCache) CustomViews(module Module) ([]CustomView, error) {
+	v, err := m.get("custom_views:"+string(module), func() (interface{}, error) {
+		resp, err := m.api.GetCustomViews(module)
+		return resp.CustomViews, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]CustomView), nil
+}
+
+// RelatedLists returns the related lists of the module
+func (m *MetadataCache) RelatedLists(module Module) ([]RelatedList, error) {
+	v, err := m.get("related_lists:"+string(module), func() (interface{}, error) {
+		resp, err := m.api.GetRelatedLists(module)
+		return resp.RelatedLists, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]RelatedList), nil
+}
+
+// ValidateRecord checks the record, a struct or map marshalled as the JSON sent to Zoho, against
+// the fields of the module. It reports fields which do not exist or are read only, picklist values
+// which are not allowed and text longer than the field allows.
+func (m *MetadataCache) ValidateRecord(module Module, record interface{}) error {
+	fields, err := m.Fields(module)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]Field, len(fields))
+	for _, field := range fields {
+		byName[field.APIName] = field
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("Failed to marshal record: %s", err)
+	}
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return fmt.Errorf("Failed to validate record: %s", err)
+	}
+
+	var problems []string
+	for name, value := range values {
+		// Properties such as $approved and the record id are not fields, null values clear them
+		if strings.HasPrefix(name, "$") || name == "id" || value == nil {
+			continue
+		}
+
+		field, ok := byName[name]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s is not a field of %s", name, module))
+		case field.IsReadOnly():
+			problems = append(problems, fmt.Sprintf("%s is read only", name))
+		default:
+			problems = append(problems, validateFieldValue(field, value)...)
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid %s record: %s", module, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// validateFieldValue returns the problems of value for field
+func validateFieldValue(field Field, value interface{}) []string {
+	var problems []string
+	switch v := value.(type) {
+	case string:
+		if field.DataType == "picklist" && v != "" && !field.HasPickListValue(v) {
+			problems = append(problems, fmt.Sprintf("%q is not a value of %s", v, field.APIName))
+		}
+		if field.Length > 0 && len([]rune(v)) > field.Length {
+			problems = append(problems, fmt.Sprintf(
+				"%s is longer than %d characters",
+				field.APIName,
+				field.Length,
+			))
+		}
+	case []interface{}:
+		if field.DataType == "multiselectpicklist" {
+			for _, item := range v {
+				if s, ok := item.(string); ok && !field.HasPickListValue(s) {
+					problems = append(problems, fmt.Sprintf(
+						"%q is not a value of %s",
+						s,
+						field.APIName,
+					))
+				}
+			}
+		}
+	}
+	return problems
+}
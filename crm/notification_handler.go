@@ -0,0 +1,344 @@
+package crm
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// NotificationOperation is the operation on records reported by a notification
+type NotificationOperation string
+
+// Proper names for the operations reported by notifications
+const (
+	NotificationInsert NotificationOperation = "insert"
+	NotificationUpdate NotificationOperation = "update"
+	NotificationDelete NotificationOperation = "delete"
+)
+
+// NotificationEvent is a notification sent by Zoho to the NotifyURL of a channel
+type NotificationEvent struct {
+	ChannelID   string
+	Module      Module
+	Operation   NotificationOperation
+	IDs         []string
+	ResourceURI string
+	ServerTime  Time
+	// AffectedFields are the API names of the fields changed by an update, by record ID
+	AffectedFields map[string][]string
+	QueryParams    map[string]interface{}
+}
+
+// NotificationFunc is called with the events dispatched by a NotificationHandler
+type NotificationFunc func(event NotificationEvent)
+
+// notificationPayload is the body of the notifications posted by Zoho
+type notificationPayload struct {
+	ServerTime     Time                   `json:"server_time"`
+	QueryParams    map[string]interface{} `json:"query_params"`
+	Module         Module                 `json:"module"`
+	ResourceURI    string                 `json:"resource_uri"`
+	IDs            []string               `json:"ids"`
+	AffectedFields []map[string][]string  `json:"affected_fields"`
+	Operation      NotificationOperation  `json:"operation"`
+	ChannelID      json.Number            `json:"channel_id"`
+	Token          string                 `json:"token"`
+}
+
+// NotificationHandler is an http.Handler receiving the notifications of the channels it manages.
+// The token of each notification is checked against its channel, and the event is dispatched to
+// the functions registered for its module and operation. Channels are renewed before they expire
+// until they are unsubscribed or the handler is closed.
+//
+//	h := crm.NewNotificationHandler(c)
+//	h.Handle(crm.LeadsModule, crm.NotificationInsert, func(e crm.NotificationEvent) {
+//	    fmt.Println("new leads", e.IDs)
+//	})
+//	http.Handle("/zoho/notify", h)
+//	_, err := h.Subscribe(crm.NotificationChannel{
+//	    ChannelID: "1000000068001",
+//	    Events:    []string{crm.NotificationEventName(crm.LeadsModule, crm.NotifyAll)},
+//	    NotifyURL: "https://example.com/zoho/notify",
+//	})
+type NotificationHandler struct {
+	api *API
+
+	// Lifetime is how long channels are enabled for when subscribed or renewed, Zoho accepts at
+	// most one day
+	Lifetime time.Duration
+	// RenewBefore is how long before their expiry channels are renewed
+	RenewBefore time.Duration
+	// ErrorLog is called with the errors of renewals and rejected notifications when not nil
+	ErrorLog func(err error)
+
+	mu       sync.Mutex
+	channels map[string]*notificationWatch
+	funcs    []notificationRoute
+	closed   bool
+}
+
+type notificationWatch struct {
+	channel NotificationChannel
+	timer   *time.Timer
+}
+
+// stop cancels the pending renewal of the channel
+func (w *notificationWatch) stop() {
+	if w.timer != nil {
+		w.timer.Stop()
+	}
+}
+
+type notificationRoute struct {
+	module    Module
+	operation NotificationOperation
+	fn        NotificationFunc
+}
+
+// NewNotificationHandler returns a NotificationHandler managing channels with api, enabled for 12
+// hours and renewed 10 minutes before they expire
+func NewNotificationHandler(api *API) *NotificationHandler {
+	return &NotificationHandler{
+		api:         api,
+		Lifetime:    12 * time.Hour,
+		RenewBefore: 10 * time.Minute,
+		channels:    map[string]*notificationWatch{},
+	}
+}
+
+// Handle registers fn to be called with the events of module for operation, an empty module or
+// operation matches every module or operation. The functions are called in the order they are
+// registered before the notification is acknowledged, so they should return quickly.
+func (h *NotificationHandler) Handle(
+	module Module,
+	operation NotificationOperation,
+	fn NotificationFunc,
+) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.funcs = append(h.funcs, notificationRoute{module: module, operation: operation, fn: fn})
+}
+
+// Subscribe will enable the notifications of channel, expiring after the Lifetime of the handler,
+// and manage the channel until it is unsubscribed. A random token is generated when the channel
+// has none.
+func (h *NotificationHandler) Subscribe(
+	channel NotificationChannel,
+) (data NotificationsResponse, err error) {
+	if channel.ChannelID == "" {
+		return NotificationsResponse{}, fmt.Errorf("Failed to subscribe, channel has no ID")
+	}
+	if channel.Token == "" {
+		token := make([]byte, 16)
+		if _, err := rand.Read(token); err != nil {
+			return NotificationsResponse{}, fmt.Errorf("Failed to generate channel token: %s", err)
+		}
+		channel.Token = hex.EncodeToString(token)
+	}
+	expiry := Time(time.Now().Add(h.Lifetime).Truncate(time.Second))
+	channel.ChannelExpiry = &expiry
+
+	data, err = h.api.EnableNotifications(NotificationsData{Watch: []NotificationChannel{channel}})
+	if err != nil {
+		return data, err
+	}
+	if err := notificationsError(data); err != nil {
+		return data, fmt.Errorf("Failed to enable notifications: %s", err)
+	}
+
+	h.Watch(channel)
+	return data, nil
+}
+
+// Watch will manage channel, already enabled with EnableNotifications or by another process,
+// accepting its notifications and renewing it before its ChannelExpiry
+func (h *NotificationHandler) Watch(channel NotificationChannel) {
+	id := channel.ChannelID.String()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if w, ok := h.channels[id]; ok {
+		w.stop()
+	}
+	w := &notificationWatch{channel: channel}
+	h.channels[id] = w
+	h.schedule(id, w, h.renewalDelay(channel))
+}
+
+// Unsubscribe will disable the notifications of the channels specified by channelIDs and stop
+// managing them
+func (h *NotificationHandler) Unsubscribe(channelIDs ...string) (err error) {
+	h.mu.Lock()
+	for _, id := range channelIDs {
+		if w, ok := h.channels[id]; ok {
+			w.stop()
+			delete(h.channels, id)
+		}
+	}
+	h.mu.Unlock()
+
+	data, err := h.api.DisableNotifications(channelIDs)
+	if err != nil {
+		return err
+	}
+	if err := notificationsError(data); err != nil {
+		return fmt.Errorf("Failed to disable notifications: %s", err)
+	}
+	return nil
+}
+
+// Close will stop renewing the channels of the handler, they stay enabled until they expire and
+// their notifications are still accepted by the handler
+func (h *NotificationHandler) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for _, w := range h.channels {
+		w.stop()
+	}
+}
+
+// ServeHTTP receives a notification posted by Zoho, rejecting it when its channel is not managed
+// by the handler or its token does not match, and dispatches its event
+func (h *NotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	defer r.Body.Close()
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	payload := notificationPayload{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		h.logError(fmt.Errorf("Failed to decode notification: %s", err))
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	id := payload.ChannelID.String()
+	h.mu.Lock()
+	watch, ok := h.channels[id]
+	var token string
+	if ok {
+		token = watch.channel.Token
+	}
+	var funcs []NotificationFunc
+	for _, route := range h.funcs {
+		if (route.module == "" || route.module == payload.Module) &&
+			(route.operation == "" || route.operation == payload.Operation) {
+			funcs = append(funcs, route.fn)
+		}
+	}
+	h.mu.Unlock()
+
+	if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(payload.Token)) != 1 {
+		h.logError(fmt.Errorf("Rejected notification of channel (%s): invalid token", id))
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	event := NotificationEvent{
+		ChannelID:      id,
+		Module:         payload.Module,
+		Operation:      payload.Operation,
+		IDs:            payload.IDs,
+		ResourceURI:    payload.ResourceURI,
+		ServerTime:     payload.ServerTime,
+		AffectedFields: map[string][]string{},
+		QueryParams:    payload.QueryParams,
+	}
+	for _, affected := range payload.AffectedFields {
+		for recordID, fields := range affected {
+			event.AffectedFields[recordID] = append(event.AffectedFields[recordID], fields...)
+		}
+	}
+
+	for _, fn := range funcs {
+		fn(event)
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// renew extends the expiry of the channel specified by id, retrying every minute on failure until
+// the channel expires
+func (h *NotificationHandler) renew(id string) {
+	h.mu.Lock()
+	w, ok := h.channels[id]
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+	channel := w.channel
+	h.mu.Unlock()
+
+	previous := channel.ChannelExpiry
+	expiry := Time(time.Now().Add(h.Lifetime).Truncate(time.Second))
+	channel.ChannelExpiry = &expiry
+
+	data, err := h.api.UpdateNotifications(NotificationsData{Watch: []NotificationChannel{channel}})
+	if err == nil {
+		err = notificationsError(data)
+	}
+
+	if err != nil {
+		h.logError(fmt.Errorf("Failed to renew notification channel (%s): %s", id, err))
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.channels[id] != w {
+		// The channel was unsubscribed or replaced during the renewal
+		return
+	}
+	if err != nil {
+		if previous != nil && time.Now().After(previous.Std()) {
+			delete(h.channels, id)
+			return
+		}
+		h.schedule(id, w, time.Minute)
+		return
+	}
+	w.channel = channel
+	h.schedule(id, w, h.renewalDelay(channel))
+}
+
+// schedule renews the channel of w after delay unless the handler is closed, h.mu must be held
+func (h *NotificationHandler) schedule(id string, w *notificationWatch, delay time.Duration) {
+	if h.closed {
+		return
+	}
+	w.timer = time.AfterFunc(delay, func() { h.renew(id) })
+}
+
+// renewalDelay returns how long until channel must be renewed
+func (h *NotificationHandler) renewalDelay(channel NotificationChannel) time.Duration {
+	if channel.ChannelExpiry == nil {
+		return 0
+	}
+	delay := time.Until(channel.ChannelExpiry.Std()) - h.RenewBefore
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
+
+func (h *NotificationHandler) logError(err error) {
+	if h.ErrorLog != nil {
+		h.ErrorLog(err)
+	}
+}
+
+// notificationsError returns the first error reported for the channels of data
+func notificationsError(data NotificationsResponse) error {
+	for _, watch := range data.Watch {
+		if watch.Status != "" && watch.Status != "success" {
+			return fmt.Errorf("%s: %s", watch.Code, watch.Message)
+		}
+	}
+	return nil
+}
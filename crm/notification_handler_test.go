@@ -0,0 +1,193 @@
+package crm
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+const testNotification = `{
+	"server_time": 1557388010870,
+	"module": "Leads",
+	"resource_uri": "https://www.zohoapis.com/crm/v2/Leads",
+	"ids": ["1", "2"],
+	"affected_fields": [{"1": ["Phone"]}, {"1": ["Email"], "2": ["Email"]}],
+	"operation": "update",
+	"channel_id": 1000000068001,
+	"token": "secret"
+}`
+
+func testChannel(expiry time.Time) NotificationChannel {
+	channelExpiry := Time(expiry.Truncate(time.Second))
+	return NotificationChannel{
+		ChannelID:     "1000000068001",
+		Events:        []string{NotificationEventName(LeadsModule, NotifyAll)},
+		ChannelExpiry: &channelExpiry,
+		Token:         "secret",
+		NotifyURL:     "https://example.com/zoho/notify",
+	}
+}
+
+func postNotification(h http.Handler, method string, body string) int {
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(method, "/zoho/notify", strings.NewReader(body)))
+	return w.Code
+}
+
+func TestNotificationHandlerRejects(t *testing.T) {
+	h := NewNotificationHandler(nil)
+	h.Watch(testChannel(time.Now().Add(time.Hour)))
+	defer h.Close()
+
+	called := false
+	h.Handle("", "", func(e NotificationEvent) { called = true })
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+		want   int
+	}{
+		{name: "method", method: http.MethodGet, want: http.StatusMethodNotAllowed},
+		{name: "body", method: http.MethodPost, body: `{"token":`, want: http.StatusBadRequest},
+		{
+			name:   "token",
+			method: http.MethodPost,
+			body:   strings.Replace(testNotification, `"secret"`, `"guess"`, 1),
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "no token",
+			method: http.MethodPost,
+			body:   strings.Replace(testNotification, `"token": "secret"`, `"token": ""`, 1),
+			want:   http.StatusForbidden,
+		},
+		{
+			name:   "channel",
+			method: http.MethodPost,
+			body:   strings.Replace(testNotification, `1000000068001`, `1000000068002`, 1),
+			want:   http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		if got := postNotification(h, tt.method, tt.body); got != tt.want {
+			t.Errorf("%s: status = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+	if called {
+		t.Errorf("rejected notifications were dispatched")
+	}
+}
+
+func TestNotificationHandlerRouting(t *testing.T) {
+	h := NewNotificationHandler(nil)
+	h.Watch(testChannel(time.Now().Add(time.Hour)))
+	defer h.Close()
+
+	var calls []string
+	var event NotificationEvent
+	h.Handle(LeadsModule, NotificationUpdate, func(e NotificationEvent) {
+		calls = append(calls, "leads update")
+		event = e
+	})
+	h.Handle(LeadsModule, "", func(e NotificationEvent) { calls = append(calls, "leads") })
+	h.Handle(LeadsModule, NotificationInsert, func(e NotificationEvent) {
+		calls = append(calls, "leads insert")
+	})
+	h.Handle(DealsModule, "", func(e NotificationEvent) { calls = append(calls, "deals") })
+	h.Handle("", "", func(e NotificationEvent) { calls = append(calls, "all") })
+
+	if got := postNotification(h, http.MethodPost, testNotification); got != http.StatusOK {
+		t.Fatalf("status = %d, want %d", got, http.StatusOK)
+	}
+
+	if want := []string{"leads update", "leads", "all"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+	if event.ChannelID != "1000000068001" || event.Module != LeadsModule ||
+		!reflect.DeepEqual(event.IDs, []string{"1", "2"}) {
+		t.Errorf("event = %+v", event)
+	}
+	affected := map[string][]string{"1": {"Phone", "Email"}, "2": {"Email"}}
+	if !reflect.DeepEqual(event.AffectedFields, affected) {
+		t.Errorf("AffectedFields = %v, want %v", event.AffectedFields, affected)
+	}
+	if !event.ServerTime.Std().Equal(time.Unix(1557388010, 870*int64(time.Millisecond))) {
+		t.Errorf("ServerTime = %s", event.ServerTime)
+	}
+}
+
+func TestNotificationHandlerClose(t *testing.T) {
+	h := NewNotificationHandler(nil)
+	h.Watch(testChannel(time.Now().Add(time.Hour)))
+	h.Close()
+
+	// Notifications of the channels are still validated after the handler is closed
+	if got := postNotification(h, http.MethodPost, testNotification); got != http.StatusOK {
+		t.Errorf("status after Close = %d, want %d", got, http.StatusOK)
+	}
+	invalid := strings.Replace(testNotification, `"secret"`, `"guess"`, 1)
+	if got := postNotification(h, http.MethodPost, invalid); got != http.StatusForbidden {
+		t.Errorf("status of invalid token after Close = %d, want %d", got, http.StatusForbidden)
+	}
+}
+
+func TestNotificationHandlerRenew(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	renewed := make(chan NotificationsData, 1)
+	s.Handle(http.MethodPut, "/crm/v2/actions/watch", func(w http.ResponseWriter, r *http.Request) {
+		data := NotificationsData{}
+		zohotest.DecodeJSON(t, r, &data)
+		w.Write([]byte(`{"watch":[{"code":"SUCCESS","status":"success","message":"ok"}]}`))
+		renewed <- data
+	})
+
+	h := NewNotificationHandler(New(s.Client()))
+	h.ErrorLog = func(err error) { t.Errorf("ErrorLog: %s", err) }
+	// The channel expires within RenewBefore so it is renewed immediately
+	h.Watch(testChannel(time.Now().Add(time.Minute)))
+	defer h.Close()
+
+	var data NotificationsData
+	select {
+	case data = <-renewed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("channel was not renewed")
+	}
+
+	if len(data.Watch) != 1 {
+		t.Fatalf("renewed %d channels, want 1", len(data.Watch))
+	}
+	channel := data.Watch[0]
+	if channel.ChannelID != "1000000068001" || channel.Token != "secret" ||
+		channel.NotifyURL != "https://example.com/zoho/notify" {
+		t.Errorf("renewed channel = %+v", channel)
+	}
+	expiry := time.Now().Add(h.Lifetime)
+	if channel.ChannelExpiry == nil || expiry.Sub(channel.ChannelExpiry.Std()) > time.Minute {
+		t.Errorf("renewed expiry = %v, want about %s", channel.ChannelExpiry, expiry)
+	}
+
+	// The renewed expiry is kept by the handler once the response is received
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		h.mu.Lock()
+		current := h.channels["1000000068001"].channel.ChannelExpiry
+		h.mu.Unlock()
+		if current.Std().Equal(channel.ChannelExpiry.Std()) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("handler expiry = %s, want %s", current, channel.ChannelExpiry)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
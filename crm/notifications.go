@@ -0,0 +1,194 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// NotificationAction is an action of a module which a notification channel subscribes to
+type NotificationAction string
+
+// Proper names for the actions of a module that notifications are sent for
+const (
+	NotifyCreate NotificationAction = "create"
+	NotifyEdit   NotificationAction = "edit"
+	NotifyDelete NotificationAction = "delete"
+	NotifyAll    NotificationAction = "all"
+)
+
+// NotificationEventName returns the name of the event of module for action, as provided in the
+// Events of a NotificationChannel, such as "Leads.create"
+func NotificationEventName(module Module, action NotificationAction) string {
+	return fmt.Sprintf("%s.%s", module, action)
+}
+
+// EnableNotifications will subscribe the channels of request to the events of their modules,
+// Zoho then posts a notification to the NotifyURL of the channel each time an event occurs. A
+// channel expires after one hour unless ChannelExpiry is provided, at most one day ahead.
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/enable.html
+func (c *API) EnableNotifications(
+	request NotificationsData,
+) (data NotificationsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "notifications",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/actions/watch", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &NotificationsResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return NotificationsResponse{}, fmt.Errorf("Failed to enable notifications: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*NotificationsResponse); ok {
+		return *v, nil
+	}
+
+	return NotificationsResponse{}, fmt.Errorf("Data retrieved was not 'NotificationsResponse'")
+}
+
+// UpdateNotifications will replace the details of the channels of request, specified by their
+// ChannelID, such as their events, token or expiry. Every detail of the channel must be provided.
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/update-info.html
+func (c *API) UpdateNotifications(
+	request NotificationsData,
+) (data NotificationsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "notifications",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/actions/watch", c.ZohoTLD),
+		Method:       zoho.HTTPPut,
+		ResponseData: &NotificationsResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return NotificationsResponse{}, fmt.Errorf("Failed to update notifications: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*NotificationsResponse); ok {
+		return *v, nil
+	}
+
+	return NotificationsResponse{}, fmt.Errorf("Data retrieved was not 'NotificationsResponse'")
+}
+
+// GetNotifications will return the details of the enabled notification channels, the 'module'
+// and 'channel_id' parameters limit them to a module or channel
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/get-details.html
+func (c *API) GetNotifications(
+	params map[string]zoho.Parameter,
+) (data NotificationsDetailsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "notifications",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/actions/watch", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &NotificationsDetailsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module":     "",
+			"channel_id": "",
+			"page":       "",
+			"per_page":   "200",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return NotificationsDetailsResponse{}, fmt.Errorf(
+			"Failed to retrieve notifications: %s",
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*NotificationsDetailsResponse); ok {
+		return *v, nil
+	}
+
+	return NotificationsDetailsResponse{}, fmt.Errorf(
+		"Data retrieved was not 'NotificationsDetailsResponse'",
+	)
+}
+
+// DisableNotifications will stop the notifications of the channels specified by channelIDs
+// https://www.zoho.com/crm/developer/docs/api/v2/notifications/disable.html
+func (c *API) DisableNotifications(channelIDs []string) (data NotificationsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "notifications",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/actions/watch", c.ZohoTLD),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &NotificationsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"channel_ids": zoho.Parameter(strings.Join(channelIDs, ",")),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return NotificationsResponse{}, fmt.Errorf("Failed to disable notifications: %s", err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*NotificationsResponse); ok {
+		return *v, nil
+	}
+
+	return NotificationsResponse{}, fmt.Errorf("Data retrieved was not 'NotificationsResponse'")
+}
+
+// NotificationsData is the data provided to EnableNotifications and UpdateNotifications
+type NotificationsData struct {
+	Watch []NotificationChannel `json:"watch"`
+}
+
+// NotificationChannel is a subscription to the events of modules. The ChannelID is chosen by the
+// caller, and the Token is sent back with every notification so that it can be verified.
+type NotificationChannel struct {
+	ChannelID                 json.Number `json:"channel_id,omitempty"`
+	Events                    []string    `json:"events,omitempty"` // see NotificationEventName
+	ChannelExpiry             *Time       `json:"channel_expiry,omitempty"`
+	Token                     string      `json:"token,omitempty"`
+	NotifyURL                 string      `json:"notify_url,omitempty"`
+	NotifyOnRelatedAction     bool        `json:"notify_on_related_action,omitempty"`
+	ReturnAffectedFieldValues bool        `json:"return_affected_field_values,omitempty"`
+}
+
+// NotificationsResponse is the data returned by EnableNotifications, UpdateNotifications and
+// DisableNotifications
+type NotificationsResponse struct {
+	Watch []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			Events []struct {
+				ChannelExpiry *Time       `json:"channel_expiry,omitempty"`
+				ResourceURI   string      `json:"resource_uri,omitempty"`
+				ResourceID    string      `json:"resource_id,omitempty"`
+				ResourceName  string      `json:"resource_name,omitempty"`
+				ChannelID     json.Number `json:"channel_id,omitempty"`
+			} `json:"events,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"watch,omitempty"`
+}
+
+// NotificationDetails is an enabled notification channel returned by GetNotifications
+type NotificationDetails struct {
+	NotificationChannel
+	ResourceURI  string `json:"resource_uri,omitempty"`
+	ResourceID   string `json:"resource_id,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+}
+
+// NotificationsDetailsResponse is the data returned by GetNotifications
+type NotificationsDetailsResponse struct {
+	Watch []NotificationDetails `json:"watch,omitempty"`
+	Info  PageInfo              `json:"info,omitempty"`
+}
@@ -0,0 +1,126 @@
+package crm
+
+import (
+	"fmt"
+	"io"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// UploadPhoto will set the photo of the record specified by id in the specified module, such as
+// a Lead or Contact. The contents of photo are streamed to Zoho, fileName is the name of the image.
+// https://www.zoho.com/crm/developer/docs/api/v2/upload-image.html
+func (c *API) UploadPhoto(
+	module Module,
+	id string,
+	fileName string,
+	photo io.Reader,
+) (data PhotoResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "photo",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/photo",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:           zoho.HTTPPost,
+		ResponseData:     &PhotoResponse{},
+		BodyFormat:       zoho.FILE,
+		AttachmentReader: photo,
+		AttachmentName:   fileName,
+		AttachmentField:  "file",
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PhotoResponse{}, fmt.Errorf(
+			"Failed to upload photo of %s record (%s): %s",
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PhotoResponse); ok {
+		return *v, nil
+	}
+
+	return PhotoResponse{}, fmt.Errorf("Data retrieved was not 'PhotoResponse'")
+}
+
+// DownloadPhoto will write the photo of the record specified by id in the specified module to w.
+// The photo is streamed from Zoho without being held in memory.
+// https://www.zoho.com/crm/developer/docs/api/v2/download-image.html
+func (c *API) DownloadPhoto(module Module, id string, w io.Writer) (err error) {
+	endpoint := zoho.Endpoint{
+		Name: "photo",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/photo",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:         zoho.HTTPGet,
+		ResponseData:   &Error{},
+		ResponseWriter: w,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return fmt.Errorf("Failed to download photo of %s record (%s): %s", module, id, err)
+	}
+
+	// An error body is decoded when Zoho did not return the photo
+	if v, ok := endpoint.ResponseData.(*Error); ok && v.Code != "" {
+		return fmt.Errorf(
+			"Failed to download photo of %s record (%s): %s: %s",
+			module,
+			id,
+			v.Code,
+			v.Message,
+		)
+	}
+
+	return nil
+}
+
+// DeletePhoto will remove the photo of the record specified by id in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/delete-image.html
+func (c *API) DeletePhoto(module Module, id string) (data PhotoResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "photo",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/photo",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &PhotoResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return PhotoResponse{}, fmt.Errorf(
+			"Failed to delete photo of %s record (%s): %s",
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*PhotoResponse); ok {
+		return *v, nil
+	}
+
+	return PhotoResponse{}, fmt.Errorf("Data retrieved was not 'PhotoResponse'")
+}
+
+// PhotoResponse is the data returned by UploadPhoto and DeletePhoto
+type PhotoResponse struct {
+	Code    string      `json:"code,omitempty"`
+	Details interface{} `json:"details,omitempty"`
+	Message string      `json:"message,omitempty"`
+	Status  string      `json:"status,omitempty"`
+}
@@ -2,7 +2,6 @@ package crm
 
 import (
 	"fmt"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -105,8 +104,8 @@ type InsertRecordsResponseData struct {
 			ID   string `json:"id,omitempty"`
 			Name string `json:"name,omitempty"`
 		} `json:"modified_by,omitempty"`
-		ModifiedTime time.Time `json:"modified_time,omitempty"`
-		CreatedTime  time.Time `json:"created_time,omitempty"`
+		ModifiedTime Time `json:"modified_time,omitempty"`
+		CreatedTime  Time `json:"created_time,omitempty"`
 	} `json:"details,omitempty"`
 	Status string `json:"status,omitempty"`
 	Code   string `json:"code,omitempty"`
@@ -120,14 +119,18 @@ type InsertRecordsResponse struct {
 // UpdateRecords will modify records by the data provided to request in the specified module
 // https://www.zoho.com/crm/help/api/v2/#ra-update-records
 //
-// When performing an update, because the natural state of the records fields in this package is to 'omitempty',
-// if you want to empty the fields contents you will need to embed the records type in a struct in your own package,
-// and override the field with a field that has a json tag that does not contain 'omitempty'.
+// Fields of the records types in this package are 'omitempty', so empty values are not sent to zoho.
+// Records can be provided as any struct or map, the fields to clear are provided as a *Nullable
+// set to zoho.NullValue(), and nil *Nullable fields are left untouched.
 // eg.
-//    type struct Account {
-//        crm.Account
-//        CustomField string `json:"Custom_Field"`
-//     }
+//
+//	type Account struct {
+//	    ID          string        `json:"id"`
+//	    Phone       *crm.Nullable `json:"Phone,omitempty"`
+//	    CustomField *crm.Nullable `json:"Custom_Field,omitempty"`
+//	}
+//
+//	Account{ID: id, Phone: zoho.NewNullable("555"), CustomField: zoho.NullValue()}
 func (c *API) UpdateRecords(
 	request UpdateRecordsData,
 	module Module,
@@ -167,14 +170,18 @@ type UpdateRecordsResponse struct {
 // UpsertRecords will insert the provided records in the request, if they already exist it will be updated
 // https://www.zoho.com/crm/help/api/v2/#ra-insert-or-update
 //
-// When performing an upsert, because the natural state of the records fields in this package is to 'omitempty' when encoding json,
-// if you want to empty the fields contents in zoho you will need to embed the records type in a struct in your own package,
-// and override the field with a field that has a json tag that does not contain 'omitempty'.
+// Fields of the records types in this package are 'omitempty', so empty values are not sent to zoho.
+// Records can be provided as any struct or map, the fields to clear are provided as a *Nullable
+// set to zoho.NullValue(), and nil *Nullable fields are left untouched.
 // eg.
-//    type struct Account {
-//        crm.Account
-//        CustomField string `json:"Custom_Field"`
-//     }
+//
+//	type Account struct {
+//	    ID          string        `json:"id"`
+//	    Phone       *crm.Nullable `json:"Phone,omitempty"`
+//	    CustomField *crm.Nullable `json:"Custom_Field,omitempty"`
+//	}
+//
+//	Account{ID: id, Phone: zoho.NewNullable("555"), CustomField: zoho.NullValue()}
 func (c *API) UpsertRecords(
 	request UpsertRecordsData,
 	module Module,
@@ -239,8 +246,8 @@ type UpsertRecordsResponse struct {
 				ID   string `json:"id,omitempty"`
 				Name string `json:"name,omitempty"`
 			} `json:"modified_by,omitempty"`
-			ModifiedTime time.Time `json:"modified_time,omitempty"`
-			CreatedTime  time.Time `json:"created_time,omitempty"`
+			ModifiedTime Time `json:"modified_time,omitempty"`
+			CreatedTime  Time `json:"created_time,omitempty"`
 		} `json:"details,omitempty"`
 		Status         string `json:"status,omitempty"`
 		DuplicateField string `json:"duplicate_field,omitempty"`
@@ -370,14 +377,23 @@ type ListDeletedRecordsResponse struct {
 			Name string `json:"name,omitempty"`
 			ID   string `json:"id,omitempty"`
 		} `json:"created_by,omitempty"`
-		DeletedTime time.Time `json:"deleted_time,omitempty"`
+		DeletedTime Time `json:"deleted_time,omitempty"`
 	} `json:"data,omitempty"`
 	Info PageInfo `json:"info,omitempty"`
 }
 
 // SearchRecords is used for searching records in the specified module using the parameters.
-// Parameters are 'criteria', 'email', 'phone', and 'word'
+// Parameters are 'criteria', 'email', 'phone', and 'word', the 'criteria' is best built with zoho.Criteria
 // https://www.zoho.com/crm/help/api/v2/#ra-search-records
+//
+//	criteria, err := zoho.And(
+//	    zoho.Equals("Email", "a@b.com"),
+//	    zoho.StartsWith("Company", "Acme"),
+//	).Parameter()
+//	if err != nil {
+//	    return err
+//	}
+//	crm.SearchRecords(&data, crm.LeadsModule, map[string]zoho.Parameter{"criteria": criteria})
 func (c *API) SearchRecords(
 	response interface{},
 	module Module,
@@ -575,11 +591,11 @@ type ConvertLeadData struct {
 		Contacts             string `json:"Contacts,omitempty"`
 		AssignTo             string `json:"assign_to,omitempty"`
 		Deals                struct {
-			CampaignSource string  `json:"Campaign_Source,omitempty"`
-			DealName       string  `json:"Deal_Name,omitempty"`
-			ClosingDate    string  `json:"Closing_Date,omitempty"`
-			Stage          string  `json:"Stage,omitempty"`
-			Amount         float64 `json:"Amount,omitempty"`
+			CampaignSource string    `json:"Campaign_Source,omitempty"`
+			DealName       string    `json:"Deal_Name,omitempty"`
+			ClosingDate    *Date     `json:"Closing_Date,omitempty"`
+			Stage          string    `json:"Stage,omitempty"`
+			Amount         *Currency `json:"Amount,omitempty"`
 		} `json:"Deals,omitempty"`
 	} `json:"data,omitempty"`
 }
@@ -15,7 +15,7 @@ type Account struct {
 		ShippingState    string      `json:"Shipping_State,omitempty"`
 		Website          string      `json:"Website,omitempty"`
 		Employees        int         `json:"Employees,omitempty"`
-		LastActivityTime string      `json:"Last_Activity_Time,omitempty"`
+		LastActivityTime *Time       `json:"Last_Activity_Time,omitempty"`
 		Industry         string      `json:"Industry,omitempty"`
 		RecordImage      interface{} `json:"Record_Image,omitempty"`
 		ModifiedBy       struct {
@@ -39,9 +39,9 @@ type Account struct {
 			Reject   bool `json:"reject,omitempty"`
 			Resubmit bool `json:"resubmit,omitempty"`
 		} `json:"$approval,omitempty"`
-		ModifiedTime    string        `json:"Modified_Time,omitempty"`
+		ModifiedTime    *Time         `json:"Modified_Time,omitempty"`
 		BillingStreet   string        `json:"Billing_Street,omitempty"`
-		CreatedTime     string        `json:"Created_Time,omitempty"`
+		CreatedTime     *Time         `json:"Created_Time,omitempty"`
 		Editable        bool          `json:"$editable,omitempty"`
 		BillingCode     string        `json:"Billing_Code,omitempty"`
 		Territories     []string      `json:"Territories,omitempty"`
@@ -56,9 +56,9 @@ type Account struct {
 			Name string `json:"name,omitempty"`
 			ID   string `json:"id,omitempty"`
 		} `json:"Created_By,omitempty"`
-		Fax            string `json:"Fax,omitempty"`
-		AnnualRevenue  int    `json:"Annual_Revenue,omitempty"`
-		ShippingStreet string `json:"Shipping_Street,omitempty"`
+		Fax            string    `json:"Fax,omitempty"`
+		AnnualRevenue  *Currency `json:"Annual_Revenue,omitempty"`
+		ShippingStreet string    `json:"Shipping_Street,omitempty"`
 	} `json:"data,omitempty"`
 	Info PageInfo `json:"info,omitempty"`
 }
@@ -91,10 +91,10 @@ type Call struct {
 			Reject   bool `json:"reject,omitempty"`
 			Resubmit bool `json:"resubmit,omitempty"`
 		} `json:"$approval,omitempty"`
-		ModifiedTime  string      `json:"Modified_Time,omitempty"`
+		ModifiedTime  *Time       `json:"Modified_Time,omitempty"`
 		Reminder      interface{} `json:"Reminder,omitempty"`
-		CreatedTime   string      `json:"Created_Time,omitempty"`
-		CallStartTime string      `json:"Call_Start_Time,omitempty"`
+		CreatedTime   *Time       `json:"Created_Time,omitempty"`
+		CallStartTime *Time       `json:"Call_Start_Time,omitempty"`
 		Billable      bool        `json:"Billable,omitempty"`
 		Editable      bool        `json:"$editable,omitempty"`
 		Subject       string      `json:"Subject,omitempty"`
@@ -131,9 +131,9 @@ type Campaign struct {
 		NumSent          string      `json:"Num_sent,omitempty"`
 		ProcessFlow      bool        `json:"$process_flow,omitempty"`
 		ExchangeRate     int         `json:"Exchange_Rate,omitempty"`
-		ExpectedRevenue  interface{} `json:"Expected_Revenue,omitempty"`
+		ExpectedRevenue  *Currency   `json:"Expected_Revenue,omitempty"`
 		Currency         string      `json:"Currency,omitempty"`
-		ActualCost       int         `json:"Actual_Cost,omitempty"`
+		ActualCost       *Currency   `json:"Actual_Cost,omitempty"`
 		ID               string      `json:"id,omitempty"`
 		ExpectedResponse interface{} `json:"Expected_Response,omitempty"`
 		StartDate        interface{} `json:"Start_Date,omitempty"`
@@ -145,8 +145,8 @@ type Campaign struct {
 			Reject   bool `json:"reject,omitempty"`
 			Resubmit bool `json:"resubmit,omitempty"`
 		} `json:"$approval,omitempty"`
-		ModifiedTime string `json:"Modified_Time,omitempty"`
-		CreatedTime  string `json:"Created_Time,omitempty"`
+		ModifiedTime *Time  `json:"Modified_Time,omitempty"`
+		CreatedTime  *Time  `json:"Created_Time,omitempty"`
 		Editable     bool   `json:"$editable,omitempty"`
 		Type         string `json:"Type,omitempty"`
 		CreatedBy    struct {
@@ -154,7 +154,7 @@ type Campaign struct {
 			ID   string `json:"id,omitempty"`
 		} `json:"Created_By,omitempty"`
 		Tag          []interface{} `json:"Tag,omitempty"`
-		BudgetedCost interface{}   `json:"Budgeted_Cost,omitempty"`
+		BudgetedCost *Currency     `json:"Budgeted_Cost,omitempty"`
 	} `json:"data,omitempty"`
 	Info struct {
 		PerPage     int  `json:"per_page,omitempty"`
@@ -189,10 +189,10 @@ type Contact struct {
 			Reject   bool `json:"reject,omitempty"`
 			Resubmit bool `json:"resubmit,omitempty"`
 		} `json:"$approval,omitempty"`
-		CostPerClick            float64     `json:"Cost_per_Click,omitempty"`
+		CostPerClick            *Currency   `json:"Cost_per_Click,omitempty"`
 		FirstVisitedURL         interface{} `json:"First_Visited_URL,omitempty"`
 		NegativeTouchPointScore int64       `json:"Negative_Touch_Point_Score,omitempty"`
-		CreatedTime             string      `json:"Created_Time,omitempty"`
+		CreatedTime             *Time       `json:"Created_Time,omitempty"`
 		NegativeScore           int         `json:"Negative_Score,omitempty"`
 		AdClickDate             interface{} `json:"Ad_Click_Date,omitempty"`
 		LastVisitedTime         Time        `json:"Last_Visited_Time,omitempty"`
@@ -231,7 +231,7 @@ type Contact struct {
 		VisitorScore               string        `json:"Visitor_Score,omitempty"`
 		OtherPhone                 string        `json:"Other_Phone,omitempty"`
 		OtherState                 string        `json:"Other_State,omitempty"`
-		LastActivityTime           string        `json:"Last_Activity_Time,omitempty"`
+		LastActivityTime           *Time         `json:"Last_Activity_Time,omitempty"`
 		ExchangeRate               int           `json:"Exchange_Rate,omitempty"`
 		MailingCountry             string        `json:"Mailing_Country,omitempty"`
 		Approved                   bool          `json:"$approved,omitempty"`
@@ -253,13 +253,13 @@ type Contact struct {
 		Twitter                string      `json:"Twitter,omitempty"`
 		FirstName              string      `json:"First_Name,omitempty"`
 		ConversionExportStatus interface{} `json:"Conversion_Export_Status,omitempty"`
-		CostPerConversion      float64     `json:"Cost_per_Conversion,omitempty"`
+		CostPerConversion      *Currency   `json:"Cost_per_Conversion,omitempty"`
 		ModifiedBy             struct {
 			Name string `json:"name,omitempty"`
 			ID   string `json:"id,omitempty"`
 		} `json:"Modified_By,omitempty"`
 		Phone            string      `json:"Phone,omitempty"`
-		ModifiedTime     string      `json:"Modified_Time,omitempty"`
+		ModifiedTime     *Time       `json:"Modified_Time,omitempty"`
 		MailingCity      string      `json:"Mailing_City,omitempty"`
 		DeviceType       interface{} `json:"Device_Type,omitempty"`
 		Title            string      `json:"Title,omitempty"`
@@ -296,9 +296,9 @@ type Deal struct {
 			Resubmit bool `json:"resubmit,omitempty"`
 		} `json:"$approval,omitempty"`
 		Territory    []interface{} `json:"Territory,omitempty"`
-		CostPerClick int           `json:"Cost_per_Click,omitempty"`
+		CostPerClick *Currency     `json:"Cost_per_Click,omitempty"`
 		ClickType    interface{}   `json:"Click_Type,omitempty"`
-		CreatedTime  string        `json:"Created_Time,omitempty"`
+		CreatedTime  *Time         `json:"Created_Time,omitempty"`
 		Editable     bool          `json:"$editable,omitempty"`
 		AdGroupName  interface{}   `json:"AdGroup_Name,omitempty"`
 		AdClickDate  interface{}   `json:"Ad_Click_Date,omitempty"`
@@ -310,9 +310,9 @@ type Deal struct {
 		Ad                     interface{} `json:"Ad,omitempty"`
 		CampaignSource         interface{} `json:"Campaign_Source,omitempty"`
 		SearchPartnerNetwork   interface{} `json:"Search_Partner_Network,omitempty"`
-		ClosingDate            string      `json:"Closing_Date,omitempty"`
+		ClosingDate            *Date       `json:"Closing_Date,omitempty"`
 		ConversionExportStatus string      `json:"Conversion_Export_Status,omitempty"`
-		CostPerConversion      int         `json:"Cost_per_Conversion,omitempty"`
+		CostPerConversion      *Currency   `json:"Cost_per_Conversion,omitempty"`
 		ModifiedBy             struct {
 			Name string `json:"name,omitempty"`
 			ID   string `json:"id,omitempty"`
@@ -320,9 +320,9 @@ type Deal struct {
 		LeadConversionTime   interface{} `json:"Lead_Conversion_Time,omitempty"`
 		OverallSalesDuration int         `json:"Overall_Sales_Duration,omitempty"`
 		AccountName          interface{} `json:"Account_Name,omitempty"`
-		ModifiedTime         string      `json:"Modified_Time,omitempty"`
+		ModifiedTime         *Time       `json:"Modified_Time,omitempty"`
 		Keyword              interface{} `json:"Keyword,omitempty"`
-		Amount               int         `json:"Amount,omitempty"`
+		Amount               *Currency   `json:"Amount,omitempty"`
 		DeviceType           interface{} `json:"Device_Type,omitempty"`
 		NextStep             string      `json:"Next_Step,omitempty"`
 		Probability          int         `json:"Probability,omitempty"`
@@ -332,7 +332,7 @@ type Deal struct {
 		} `json:"Contact_Name,omitempty"`
 		PredictionScore            int           `json:"Prediction_Score,omitempty"`
 		SalesCycleDuration         int           `json:"Sales_Cycle_Duration,omitempty"`
-		AmountQuoted               interface{}   `json:"Amount_Quoted,omitempty"`
+		AmountQuoted               *Currency     `json:"Amount_Quoted,omitempty"`
 		AdCampaignName             interface{}   `json:"Ad_Campaign_Name,omitempty"`
 		LeadSource                 string        `json:"Lead_Source,omitempty"`
 		Tag                        []interface{} `json:"Tag,omitempty"`
@@ -0,0 +1,45 @@
+package crm
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDealAmountJSON(t *testing.T) {
+	deals := Deal{}
+	err := json.Unmarshal(
+		[]byte(`{"data":[{"Amount":1234567.89,"Amount_Quoted":"0.10"},{"Amount":null}]}`),
+		&deals,
+	)
+	if err != nil {
+		t.Fatalf("Unmarshal returned error: %s", err)
+	}
+
+	deal := deals.Data[0]
+	if deal.Amount == nil || deal.Amount.String() != "1234567.89" {
+		t.Errorf("Amount = %v, want 1234567.89", deal.Amount)
+	}
+	if deal.AmountQuoted == nil || deal.AmountQuoted.String() != "0.10" {
+		t.Errorf("Amount_Quoted = %v, want 0.10", deal.AmountQuoted)
+	}
+	if deals.Data[1].Amount != nil {
+		t.Errorf("null Amount = %v, want nil", deals.Data[1].Amount)
+	}
+}
+
+func TestConvertLeadDealAmountJSON(t *testing.T) {
+	data := ConvertLeadData{}
+	if err := json.Unmarshal([]byte(`{"data":[{"Deals":{"Amount":0.1}},{}]}`), &data); err != nil {
+		t.Fatalf("Unmarshal returned error: %s", err)
+	}
+
+	b, err := json.Marshal(data)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %s", err)
+	}
+	// The amount is sent as provided and omitted when unset
+	want := `{"data":[{"Deals":{"Amount":0.1}},{"Deals":{}}]}`
+	if string(b) != want {
+		t.Errorf("Marshal = %s, want %s", b, want)
+	}
+}
@@ -0,0 +1,63 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// GetRelatedLists will return the related lists of the module, their API names are used to
+// retrieve the related records of a record. It requires the zoho.BuildScope(zoho.Crm,
+// zoho.SettingsScope, zoho.RelatedLists, zoho.Read) scope.
+// https://www.zoho.com/crm/developer/docs/api/v2/related-list-meta.html
+func (c *API) GetRelatedLists(module Module) (data RelatedListsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "related_lists",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/related_lists",
+			c.ZohoTLD,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &RelatedListsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedListsResponse{}, fmt.Errorf(
+			"Failed to retrieve related lists of %s: %s",
+			module,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedListsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedListsResponse{}, fmt.Errorf("Data retrieved was not 'RelatedListsResponse'")
+}
+
+// RelatedListsResponse is the data returned by GetRelatedLists
+type RelatedListsResponse struct {
+	RelatedLists []RelatedList `json:"related_lists,omitempty"`
+}
+
+// RelatedList is the metadata of a related list of a module
+type RelatedList struct {
+	ID              string       `json:"id,omitempty"`
+	APIName         string       `json:"api_name,omitempty"`
+	Name            string       `json:"name,omitempty"`
+	DisplayLabel    string       `json:"display_label,omitempty"`
+	Module          APIReference `json:"module,omitempty"`
+	SequenceNumber  json.Number  `json:"sequence_number,omitempty"`
+	Visible         bool         `json:"visible,omitempty"`
+	Action          string       `json:"action,omitempty"`
+	Href            string       `json:"href,omitempty"`
+	Type            string       `json:"type,omitempty"`
+	ConnectedModule string       `json:"connectedmodule,omitempty"`
+	LinkingModule   string       `json:"linkingmodule,omitempty"`
+}
@@ -0,0 +1,324 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// GetRelatedRecords will return the records of the related list of the record specified by id in
+// the specified module, such as the Contacts of an Account, in the provided response. The related
+// list is specified by its API name as returned by GetRelatedLists, and the records are paged
+// with the 'page' and 'per_page' parameters.
+// https://www.zoho.com/crm/developer/docs/api/v2/get-related-records.html
+func (c *API) GetRelatedRecords(
+	response interface{},
+	module Module,
+	id string,
+	relatedList string,
+	params map[string]zoho.Parameter,
+) (data interface{}, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "related_records",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/%s",
+			c.ZohoTLD,
+			module,
+			id,
+			relatedList,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: response,
+		URLParameters: map[string]zoho.Parameter{
+			"fields":   "",
+			"page":     "",
+			"per_page": "200",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"Failed to retrieve %s of %s record (%s): %s",
+			relatedList,
+			module,
+			id,
+			err,
+		)
+	}
+
+	if endpoint.ResponseData != nil {
+		return endpoint.ResponseData, nil
+	}
+
+	return nil, fmt.Errorf("Data returned was nil")
+}
+
+// GetAllRelatedRecords will decode every record of the related list of the record specified by id
+// into records, which must be a pointer to a slice. The pages are requested one after another
+// while the response reports more records, starting at the 'page' of params when it is set.
+// https://www.zoho.com/crm/developer/docs/api/v2/get-related-records.html
+func (c *API) GetAllRelatedRecords(
+	records interface{},
+	module Module,
+	id string,
+	relatedList string,
+	params map[string]zoho.Parameter,
+) (err error) {
+	slice := reflect.ValueOf(records)
+	if slice.Kind() != reflect.Ptr || slice.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("Failed, you must pass a pointer to a slice in the records parameter")
+	}
+	slice = slice.Elem()
+
+	page := 1
+	if p, ok := params["page"]; ok && p != "" {
+		if page, err = strconv.Atoi(string(p)); err != nil {
+			return fmt.Errorf("Failed, the page parameter (%s) is not a number", p)
+		}
+	}
+
+	for ; ; page++ {
+		pageParams := map[string]zoho.Parameter{}
+		for k, v := range params {
+			pageParams[k] = v
+		}
+		pageParams["page"] = zoho.Parameter(strconv.Itoa(page))
+
+		v, err := c.GetRelatedRecords(&relatedRecordsPage{}, module, id, relatedList, pageParams)
+		if err != nil {
+			return err
+		}
+		resp, ok := v.(*relatedRecordsPage)
+		if !ok {
+			return fmt.Errorf("Data retrieved was not 'relatedRecordsPage'")
+		}
+
+		// A related list without records returns no content
+		if len(resp.Data) == 0 {
+			return nil
+		}
+
+		records := reflect.New(slice.Type())
+		if err := json.Unmarshal(resp.Data, records.Interface()); err != nil {
+			return fmt.Errorf(
+				"Failed to decode %s of %s record (%s): %s",
+				relatedList,
+				module,
+				id,
+				err,
+			)
+		}
+		slice.Set(reflect.AppendSlice(slice, records.Elem()))
+
+		if !resp.Info.MoreRecords || records.Elem().Len() == 0 {
+			return nil
+		}
+	}
+}
+
+// relatedRecordsPage is a page of related records decoded by GetAllRelatedRecords
+type relatedRecordsPage struct {
+	Data json.RawMessage `json:"data,omitempty"`
+	Info PageInfo        `json:"info,omitempty"`
+}
+
+// UpdateRelatedRecords will link the records in request to the record specified by id in the
+// specified module, updating the fields of the relation such as the Contact_Role of the Contacts
+// of a Deal. Each record of request must hold the 'id' of the related record.
+// https://www.zoho.com/crm/developer/docs/api/v2/update-related-records.html
+func (c *API) UpdateRelatedRecords(
+	request UpdateRelatedRecordsData,
+	module Module,
+	id string,
+	relatedList string,
+) (data RelatedRecordsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "related_records",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/%s",
+			c.ZohoTLD,
+			module,
+			id,
+			relatedList,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &RelatedRecordsResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf(
+			"Failed to update %s of %s record (%s): %s",
+			relatedList,
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data retrieved was not 'RelatedRecordsResponse'")
+}
+
+// UpdateRelatedRecord will link the record specified by relatedID to the record specified by id in
+// the specified module, updating the fields of the relation provided in request
+// https://www.zoho.com/crm/developer/docs/api/v2/update-related-records.html
+func (c *API) UpdateRelatedRecord(
+	request UpdateRelatedRecordData,
+	module Module,
+	id string,
+	relatedList string,
+	relatedID string,
+) (data RelatedRecordsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "related_records",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/%s/%s",
+			c.ZohoTLD,
+			module,
+			id,
+			relatedList,
+			relatedID,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &RelatedRecordsResponse{},
+		RequestBody:  request,
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf(
+			"Failed to update %s (%s) of %s record (%s): %s",
+			relatedList,
+			relatedID,
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data retrieved was not 'RelatedRecordsResponse'")
+}
+
+// DelinkRelatedRecord will remove the relation between the record specified by relatedID and the
+// record specified by id in the specified module, neither record is deleted
+// https://www.zoho.com/crm/developer/docs/api/v2/delink-related-records.html
+func (c *API) DelinkRelatedRecord(
+	module Module,
+	id string,
+	relatedList string,
+	relatedID string,
+) (data RelatedRecordsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "related_records",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/%s/%s",
+			c.ZohoTLD,
+			module,
+			id,
+			relatedList,
+			relatedID,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &RelatedRecordsResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf(
+			"Failed to delink %s (%s) of %s record (%s): %s",
+			relatedList,
+			relatedID,
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data retrieved was not 'RelatedRecordsResponse'")
+}
+
+// DelinkRelatedRecords will remove the relations between the records specified by relatedIDs and
+// the record specified by id in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/delink-related-records.html
+func (c *API) DelinkRelatedRecords(
+	module Module,
+	id string,
+	relatedList string,
+	relatedIDs []string,
+) (data RelatedRecordsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "related_records",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/%s",
+			c.ZohoTLD,
+			module,
+			id,
+			relatedList,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &RelatedRecordsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"ids": zoho.Parameter(strings.Join(relatedIDs, ",")),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RelatedRecordsResponse{}, fmt.Errorf(
+			"Failed to delink %s of %s record (%s): %s",
+			relatedList,
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RelatedRecordsResponse); ok {
+		return *v, nil
+	}
+
+	return RelatedRecordsResponse{}, fmt.Errorf("Data retrieved was not 'RelatedRecordsResponse'")
+}
+
+// UpdateRelatedRecordsData is the data provided to UpdateRelatedRecords
+type UpdateRelatedRecordsData struct {
+	Data interface{} `json:"data,omitempty"`
+}
+
+// UpdateRelatedRecordData is the data provided to UpdateRelatedRecord
+type UpdateRelatedRecordData = UpdateRelatedRecordsData
+
+// RelatedRecordsResponse is the data returned by UpdateRelatedRecords, UpdateRelatedRecord,
+// DelinkRelatedRecord and DelinkRelatedRecords
+type RelatedRecordsResponse struct {
+	Data []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			ID string `json:"id,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"data,omitempty"`
+}
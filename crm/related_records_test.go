@@ -0,0 +1,60 @@
+package crm
+
+import (
+	"net/http"
+	"testing"
+
+	zoho "github.com/schmorrison/Zoho"
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestGetAllRelatedRecords(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	path := "/crm/v2/Accounts/1000/Contacts"
+	s.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("fields"); got != "Last_Name" {
+			t.Errorf("fields = %s, want Last_Name", got)
+		}
+		switch r.URL.Query().Get("page") {
+		case "1":
+			w.Write([]byte(`{"data":[{"id":"1","Last_Name":"Boyle"},{"id":"2"}],
+				"info":{"per_page":2,"count":2,"page":1,"more_records":true}}`))
+		case "2":
+			w.Write([]byte(`{"data":[{"id":"3","Last_Name":"Patricia"}],
+				"info":{"per_page":2,"count":1,"page":2,"more_records":false}}`))
+		default:
+			t.Errorf("unexpected page %s", r.URL.Query().Get("page"))
+		}
+	})
+	api := New(s.Client())
+
+	var contacts []struct {
+		ID       string `json:"id"`
+		LastName string `json:"Last_Name"`
+	}
+	err := api.GetAllRelatedRecords(&contacts, AccountsModule, "1000", "Contacts",
+		map[string]zoho.Parameter{"fields": "Last_Name", "per_page": "2"})
+	if err != nil {
+		t.Fatalf("GetAllRelatedRecords returned error: %s", err)
+	}
+	if len(contacts) != 3 || contacts[0].LastName != "Boyle" || contacts[2].ID != "3" {
+		t.Errorf("contacts = %+v, want the records of both pages", contacts)
+	}
+
+	// A related list without records returns no content
+	s.Handle(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	contacts = nil
+	err = api.GetAllRelatedRecords(&contacts, AccountsModule, "1000", "Contacts",
+		map[string]zoho.Parameter{"fields": "Last_Name"})
+	if err != nil || len(contacts) != 0 {
+		t.Errorf("GetAllRelatedRecords of an empty list = %+v, %v", contacts, err)
+	}
+
+	if err := api.GetAllRelatedRecords(contacts, AccountsModule, "1000", "Contacts", nil); err == nil {
+		t.Errorf("GetAllRelatedRecords into a slice which is not a pointer returned no error")
+	}
+}
@@ -0,0 +1,482 @@
+package crm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// Tags are added to or removed from at most 100 records at once
+const recordsTagsLimit = 100
+
+// GetTags will return the tags of the specified module, the 'my_tags' parameter limits them to the
+// tags created by the current user
+// https://www.zoho.com/crm/developer/docs/api/v2/get-tag-list.html
+func (c *API) GetTags(
+	module Module,
+	params map[string]zoho.Parameter,
+) (data TagsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/tags", c.ZohoTLD),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TagsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module":  zoho.Parameter(module),
+			"my_tags": "",
+		},
+	}
+
+	for k, v := range params {
+		endpoint.URLParameters[k] = v
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagsResponse{}, fmt.Errorf("Failed to retrieve tags of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagsResponse); ok {
+		return *v, nil
+	}
+
+	return TagsResponse{}, fmt.Errorf("Data retrieved was not 'TagsResponse'")
+}
+
+// CreateTags will create the tags of request in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/create-tags.html
+func (c *API) CreateTags(request TagsData, module Module) (data TagsActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/tags", c.ZohoTLD),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TagsActionResponse{},
+		RequestBody:  request,
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagsActionResponse{}, fmt.Errorf("Failed to create tags of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagsActionResponse); ok {
+		return *v, nil
+	}
+
+	return TagsActionResponse{}, fmt.Errorf("Data retrieved was not 'TagsActionResponse'")
+}
+
+// UpdateTags will rename the tags of request, specified by their ID, in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/update-tags.html
+func (c *API) UpdateTags(request TagsData, module Module) (data TagsActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name:         "tags",
+		URL:          fmt.Sprintf("https://www.zohoapis.%s/crm/v2/settings/tags", c.ZohoTLD),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TagsActionResponse{},
+		RequestBody:  request,
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagsActionResponse{}, fmt.Errorf("Failed to update tags of %s: %s", module, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagsActionResponse); ok {
+		return *v, nil
+	}
+
+	return TagsActionResponse{}, fmt.Errorf("Data retrieved was not 'TagsActionResponse'")
+}
+
+// UpdateTag will rename the tag specified by id in the specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/update-specific-tag.html
+func (c *API) UpdateTag(
+	module Module,
+	id string,
+	name string,
+) (data TagsActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "tags",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/tags/%s",
+			c.ZohoTLD,
+			id,
+		),
+		Method:       zoho.HTTPPut,
+		ResponseData: &TagsActionResponse{},
+		RequestBody: TagsData{
+			Tags: []TagData{{Name: name}},
+		},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagsActionResponse{}, fmt.Errorf("Failed to update tag (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagsActionResponse); ok {
+		return *v, nil
+	}
+
+	return TagsActionResponse{}, fmt.Errorf("Data retrieved was not 'TagsActionResponse'")
+}
+
+// DeleteTag will delete the tag specified by id, removing it from the records it is applied to
+// https://www.zoho.com/crm/developer/docs/api/v2/delete-tag.html
+func (c *API) DeleteTag(id string) (data TagsActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "tags",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/tags/%s",
+			c.ZohoTLD,
+			id,
+		),
+		Method:       zoho.HTTPDelete,
+		ResponseData: &TagsActionResponse{},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagsActionResponse{}, fmt.Errorf("Failed to delete tag (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagsActionResponse); ok {
+		return *v, nil
+	}
+
+	return TagsActionResponse{}, fmt.Errorf("Data retrieved was not 'TagsActionResponse'")
+}
+
+// MergeTags will merge the tag specified by id into the tag specified by conflictID, the records
+// tagged with the first are tagged with the second and the first is deleted
+// https://www.zoho.com/crm/developer/docs/api/v2/merge-tags.html
+func (c *API) MergeTags(id string, conflictID string) (data TagsActionResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "tags",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/tags/%s/actions/merge",
+			c.ZohoTLD,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &TagsActionResponse{},
+		RequestBody: TagsData{
+			Tags: []TagData{{ConflictID: conflictID}},
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return TagsActionResponse{}, fmt.Errorf(
+			"Failed to merge tag (%s) into tag (%s): %s",
+			id,
+			conflictID,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagsActionResponse); ok {
+		return *v, nil
+	}
+
+	return TagsActionResponse{}, fmt.Errorf("Data retrieved was not 'TagsActionResponse'")
+}
+
+// GetTagRecordsCount will return the number of records of the specified module tagged with the
+// tag specified by id
+// https://www.zoho.com/crm/developer/docs/api/v2/get-record-count-tags.html
+func (c *API) GetTagRecordsCount(module Module, id string) (count int, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "tags",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/settings/tags/%s/actions/records_count",
+			c.ZohoTLD,
+			id,
+		),
+		Method:       zoho.HTTPGet,
+		ResponseData: &TagRecordsCountResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"module": zoho.Parameter(module),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return 0, fmt.Errorf("Failed to retrieve records count of tag (%s): %s", id, err)
+	}
+
+	if v, ok := endpoint.ResponseData.(*TagRecordsCountResponse); ok {
+		n, err := strconv.Atoi(v.Count.String())
+		if err != nil {
+			return 0, fmt.Errorf("Failed to read records count of tag (%s): %s", id, err)
+		}
+		return n, nil
+	}
+
+	return 0, fmt.Errorf("Data retrieved was not 'TagRecordsCountResponse'")
+}
+
+// AddTags will tag the record specified by id in the specified module with the tags named
+// tagNames, creating the tags which do not exist. When overwrite is true the other tags of the
+// record are removed.
+// https://www.zoho.com/crm/developer/docs/api/v2/add-tags.html
+func (c *API) AddTags(
+	module Module,
+	id string,
+	tagNames []string,
+	overwrite bool,
+) (data RecordTagsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "tags",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/actions/add_tags",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &RecordTagsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"tag_names":  zoho.Parameter(strings.Join(tagNames, ",")),
+			"over_write": zoho.Parameter(strconv.FormatBool(overwrite)),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecordTagsResponse{}, fmt.Errorf(
+			"Failed to add tags to %s record (%s): %s",
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecordTagsResponse); ok {
+		return *v, nil
+	}
+
+	return RecordTagsResponse{}, fmt.Errorf("Data retrieved was not 'RecordTagsResponse'")
+}
+
+// AddTagsToRecords will tag the records specified by ids in the specified module with the tags
+// named tagNames, see AddTags. Zoho accepts up to 100 records at once, so the records are tagged
+// 100 at a time and the results are merged.
+// https://www.zoho.com/crm/developer/docs/api/v2/add-tags.html
+func (c *API) AddTagsToRecords(
+	module Module,
+	ids []string,
+	tagNames []string,
+	overwrite bool,
+) (data RecordTagsResponse, err error) {
+	data, err = c.recordsTags(module, "add_tags", ids, map[string]zoho.Parameter{
+		"tag_names":  zoho.Parameter(strings.Join(tagNames, ",")),
+		"over_write": zoho.Parameter(strconv.FormatBool(overwrite)),
+	})
+	if err != nil {
+		return data, fmt.Errorf("Failed to add tags to %s records: %s", module, err)
+	}
+	return data, nil
+}
+
+// RemoveTags will remove the tags named tagNames from the record specified by id in the
+// specified module
+// https://www.zoho.com/crm/developer/docs/api/v2/remove-tags.html
+func (c *API) RemoveTags(
+	module Module,
+	id string,
+	tagNames []string,
+) (data RecordTagsResponse, err error) {
+	endpoint := zoho.Endpoint{
+		Name: "tags",
+		URL: fmt.Sprintf(
+			"https://www.zohoapis.%s/crm/v2/%s/%s/actions/remove_tags",
+			c.ZohoTLD,
+			module,
+			id,
+		),
+		Method:       zoho.HTTPPost,
+		ResponseData: &RecordTagsResponse{},
+		URLParameters: map[string]zoho.Parameter{
+			"tag_names": zoho.Parameter(strings.Join(tagNames, ",")),
+		},
+	}
+
+	err = c.Zoho.HTTPRequest(&endpoint)
+	if err != nil {
+		return RecordTagsResponse{}, fmt.Errorf(
+			"Failed to remove tags from %s record (%s): %s",
+			module,
+			id,
+			err,
+		)
+	}
+
+	if v, ok := endpoint.ResponseData.(*RecordTagsResponse); ok {
+		return *v, nil
+	}
+
+	return RecordTagsResponse{}, fmt.Errorf("Data retrieved was not 'RecordTagsResponse'")
+}
+
+// RemoveTagsFromRecords will remove the tags named tagNames from the records specified by ids in
+// the specified module. Zoho accepts up to 100 records at once, so the records are updated 100 at
+// a time and the results are merged.
+// https://www.zoho.com/crm/developer/docs/api/v2/remove-tags.html
+func (c *API) RemoveTagsFromRecords(
+	module Module,
+	ids []string,
+	tagNames []string,
+) (data RecordTagsResponse, err error) {
+	data, err = c.recordsTags(module, "remove_tags", ids, map[string]zoho.Parameter{
+		"tag_names": zoho.Parameter(strings.Join(tagNames, ",")),
+	})
+	if err != nil {
+		return data, fmt.Errorf("Failed to remove tags from %s records: %s", module, err)
+	}
+	return data, nil
+}
+
+// recordsTags performs the action on the tags of the records specified by ids, 100 records at a
+// time, and returns the merged results of the batches completed until an error occurred
+func (c *API) recordsTags(
+	module Module,
+	action string,
+	ids []string,
+	params map[string]zoho.Parameter,
+) (data RecordTagsResponse, err error) {
+	for start := 0; start < len(ids); start += recordsTagsLimit {
+		end := start + recordsTagsLimit
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		endpoint := zoho.Endpoint{
+			Name: "tags",
+			URL: fmt.Sprintf(
+				"https://www.zohoapis.%s/crm/v2/%s/actions/%s",
+				c.ZohoTLD,
+				module,
+				action,
+			),
+			Method:       zoho.HTTPPost,
+			ResponseData: &RecordTagsResponse{},
+			URLParameters: map[string]zoho.Parameter{
+				"ids": zoho.Parameter(strings.Join(ids[start:end], ",")),
+			},
+		}
+		for k, v := range params {
+			endpoint.URLParameters[k] = v
+		}
+
+		err = c.Zoho.HTTPRequest(&endpoint)
+		if err != nil {
+			return data, err
+		}
+
+		v, ok := endpoint.ResponseData.(*RecordTagsResponse)
+		if !ok {
+			return data, fmt.Errorf("Data retrieved was not 'RecordTagsResponse'")
+		}
+		data.Data = append(data.Data, v.Data...)
+	}
+	return data, nil
+}
+
+// Tag is a tag of a module
+type Tag struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	CreatedBy    *Owner `json:"created_by,omitempty"`
+	ModifiedBy   *Owner `json:"modified_by,omitempty"`
+	CreatedTime  *Time  `json:"created_time,omitempty"`
+	ModifiedTime *Time  `json:"modified_time,omitempty"`
+}
+
+// TagsResponse is the data returned by GetTags
+type TagsResponse struct {
+	Tags []Tag `json:"tags,omitempty"`
+	Info struct {
+		Count        int `json:"count,omitempty"`
+		AllowedCount int `json:"allowed_count,omitempty"`
+	} `json:"info,omitempty"`
+}
+
+// TagsData is the data provided to CreateTags and UpdateTags
+type TagsData struct {
+	Tags []TagData `json:"tags"`
+}
+
+// TagData is a tag to create or update, the ID is only provided to UpdateTags
+type TagData struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	ConflictID string `json:"conflict_id,omitempty"`
+}
+
+// TagsActionResponse is the data returned by CreateTags, UpdateTags, UpdateTag, DeleteTag and
+// MergeTags
+type TagsActionResponse struct {
+	Tags []struct {
+		Code    string `json:"code,omitempty"`
+		Details Tag    `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"tags,omitempty"`
+}
+
+// UnmarshalJSON is the json unmarshalling function for TagsActionResponse, Zoho returns a single
+// result instead of a list when a single tag is deleted
+func (r *TagsActionResponse) UnmarshalJSON(b []byte) error {
+	type response TagsActionResponse
+	resp := response{}
+	if err := json.Unmarshal(b, &resp); err == nil {
+		*r = TagsActionResponse(resp)
+		return nil
+	}
+
+	single := struct {
+		Tags json.RawMessage `json:"tags"`
+	}{}
+	if err := json.Unmarshal(b, &single); err != nil {
+		return err
+	}
+	list := append(append([]byte(`{"tags":[`), single.Tags...), ']', '}')
+	if err := json.Unmarshal(list, &resp); err != nil {
+		return err
+	}
+	*r = TagsActionResponse(resp)
+	return nil
+}
+
+// TagRecordsCountResponse is the data returned by GetTagRecordsCount
+type TagRecordsCountResponse struct {
+	Count json.Number `json:"count,omitempty"`
+}
+
+// RecordTagsResponse is the data returned by AddTags, AddTagsToRecords, RemoveTags and
+// RemoveTagsFromRecords
+type RecordTagsResponse struct {
+	Data []struct {
+		Code    string `json:"code,omitempty"`
+		Details struct {
+			ID   string   `json:"id,omitempty"`
+			Tags []string `json:"tags,omitempty"`
+		} `json:"details,omitempty"`
+		Message string `json:"message,omitempty"`
+		Status  string `json:"status,omitempty"`
+	} `json:"data,omitempty"`
+}
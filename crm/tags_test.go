@@ -0,0 +1,87 @@
+package crm
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/schmorrison/Zoho/internal/zohotest"
+)
+
+func TestAddTagsToRecordsBatches(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	var batches []int
+	path := "/crm/v2/Leads/actions/add_tags"
+	s.Handle(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("tag_names"); got != "Hot,VIP" {
+			t.Errorf("tag_names = %s, want Hot,VIP", got)
+		}
+
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		batches = append(batches, len(ids))
+		results := make([]string, 0, len(ids))
+		for _, id := range ids {
+			results = append(
+				results,
+				fmt.Sprintf(`{"code":"SUCCESS","details":{"id":"%s"},"status":"success"}`, id),
+			)
+		}
+		fmt.Fprintf(w, `{"data":[%s]}`, strings.Join(results, ","))
+	})
+	api := New(s.Client())
+
+	ids := make([]string, 250)
+	for i := range ids {
+		ids[i] = fmt.Sprint(i + 1)
+	}
+
+	data, err := api.AddTagsToRecords(LeadsModule, ids, []string{"Hot", "VIP"}, false)
+	if err != nil {
+		t.Fatalf("AddTagsToRecords returned error: %s", err)
+	}
+	if len(batches) != 3 || batches[0] != 100 || batches[1] != 100 || batches[2] != 50 {
+		t.Errorf("batches = %v, want [100 100 50]", batches)
+	}
+	if len(data.Data) != len(ids) {
+		t.Fatalf("merged %d results, want %d", len(data.Data), len(ids))
+	}
+	for i, result := range data.Data {
+		if result.Details.ID != ids[i] {
+			t.Errorf("result %d is for record %s, want %s", i, result.Details.ID, ids[i])
+			break
+		}
+	}
+}
+
+func TestRemoveTagsFromRecordsError(t *testing.T) {
+	s := zohotest.NewServer(t)
+	defer s.Close()
+
+	requests := 0
+	path := "/crm/v2/Leads/actions/remove_tags"
+	s.Handle(http.MethodPost, path, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests > 1 {
+			w.Write([]byte(`{"data":[{"code":"INVALID_DATA","status":"error"}]}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"code":"SUCCESS","details":{"id":"1"},"status":"success"}]}`))
+	})
+	api := New(s.Client())
+
+	ids := make([]string, 150)
+	for i := range ids {
+		ids[i] = fmt.Sprint(i + 1)
+	}
+
+	data, err := api.RemoveTagsFromRecords(LeadsModule, ids, []string{"Hot"})
+	if err == nil {
+		t.Fatalf("RemoveTagsFromRecords returned no error")
+	}
+	if requests != 2 || len(data.Data) != 1 {
+		t.Errorf("requests = %d, results = %d, want 2 and 1", requests, len(data.Data))
+	}
+}
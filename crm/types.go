@@ -24,8 +24,9 @@ type PageInfo struct {
 type MultiSelect []string
 type Date = zoho.Date
 type Time = zoho.Time
+type Nullable = zoho.Nullable
 type Number int
-type Currency float64
+type Currency = zoho.Money
 type Decimal float64
 type Percent float64
 type Long int64
@@ -50,8 +51,7 @@ type SingleLine string
 
 func (s *SingleLine) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := SingleLine("")
-		s = &t
+		*s = ""
 		return nil
 	}
 
@@ -60,16 +60,12 @@ func (s *SingleLine) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := SingleLine(t)
-	s = &tp
+	*s = SingleLine(t)
 	return nil
 }
 
 func (s SingleLine) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // MultiLine is the field type in Zoho that defines a multiline input field, like text area in HTML
@@ -77,8 +73,7 @@ type MultiLine string
 
 func (s *MultiLine) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := MultiLine("")
-		s = &t
+		*s = ""
 		return nil
 	}
 
@@ -87,16 +82,12 @@ func (s *MultiLine) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := MultiLine(t)
-	s = &tp
+	*s = MultiLine(t)
 	return nil
 }
 
 func (s MultiLine) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // Email is the field type in Zoho that defines an email address field
@@ -104,8 +95,7 @@ type Email string
 
 func (s *Email) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := Email("")
-		s = &t
+		*s = ""
 		return nil
 	}
 
@@ -114,16 +104,12 @@ func (s *Email) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := Email(t)
-	s = &tp
+	*s = Email(t)
 	return nil
 }
 
 func (s Email) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // Phone is the field type in Zoho that defines a phone number field
@@ -131,8 +117,7 @@ type Phone string
 
 func (s *Phone) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := Phone("")
-		s = &t
+		*s = ""
 		return nil
 	}
 
@@ -141,16 +126,12 @@ func (s *Phone) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := Phone(t)
-	s = &tp
+	*s = Phone(t)
 	return nil
 }
 
 func (s Phone) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // PickList is the field type in Zoho that defines a dropdown that has been selected
@@ -158,8 +139,7 @@ type PickList string
 
 func (s *PickList) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := PickList("")
-		s = &t
+		*s = ""
 		return nil
 	}
 
@@ -168,14 +148,10 @@ func (s *PickList) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := PickList(t)
-	s = &tp
+	*s = PickList(t)
 	return nil
 }
 
 func (s PickList) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
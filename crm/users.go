@@ -108,8 +108,8 @@ type UsersResponse struct {
 		Email         string `json:"email,omitempty"`
 		ReportingTo   string `json:"Reporting_To,omitempty"`
 		Zip           string `json:"zip,omitempty"`
-		CreatedTime   string `json:"created_time,omitempty"`
-		ModifiedTime  string `json:"modified_time,omitempty"`
+		CreatedTime   *Time  `json:"created_time,omitempty"`
+		ModifiedTime  *Time  `json:"modified_time,omitempty"`
 		Website       string `json:"website,omitempty"`
 		TimeFormat    string `json:"time_format,omitempty"`
 		Offset        int64  `json:"offset,omitempty"`
@@ -37,20 +37,20 @@ type OrganizationResponse struct {
 	Code          int    `json:"code"`
 	Message       string `json:"message"`
 	Organizations []struct {
-		AccountCreatedDate   string `json:"account_created_date"`
-		ContactName          string `json:"contact_name"`
-		CurrencyCode         string `json:"currency_code"`
-		CurrencyFormat       string `json:"currency_format"`
-		CurrencyID           string `json:"currency_id"`
-		CurrencySymbol       string `json:"currency_symbol"`
-		Email                string `json:"email"`
-		FiscalYearStartMonth int    `json:"fiscal_year_start_month"`
-		IsDefaultOrg         bool   `json:"is_default_org"`
-		IsOrgActive          bool   `json:"is_org_active"`
-		LanguageCode         string `json:"language_code"`
-		Name                 string `json:"name"`
-		OrganizationID       string `json:"organization_id"`
-		PricePrecision       int    `json:"price_precision"`
-		TimeZone             string `json:"time_zone"`
+		AccountCreatedDate   zoho.Date `json:"account_created_date"`
+		ContactName          string    `json:"contact_name"`
+		CurrencyCode         string    `json:"currency_code"`
+		CurrencyFormat       string    `json:"currency_format"`
+		CurrencyID           string    `json:"currency_id"`
+		CurrencySymbol       string    `json:"currency_symbol"`
+		Email                string    `json:"email"`
+		FiscalYearStartMonth int       `json:"fiscal_year_start_month"`
+		IsDefaultOrg         bool      `json:"is_default_org"`
+		IsOrgActive          bool      `json:"is_org_active"`
+		LanguageCode         string    `json:"language_code"`
+		Name                 string    `json:"name"`
+		OrganizationID       string    `json:"organization_id"`
+		PricePrecision       int       `json:"price_precision"`
+		TimeZone             string    `json:"time_zone"`
 	} `json:"organizations"`
 }
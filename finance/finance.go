@@ -0,0 +1,148 @@
+package finance
+
+import zoho "github.com/schmorrison/Zoho"
+
+// CustomFieldRequest is used to set the value of a custom field when creating or updating a document
+type CustomFieldRequest struct {
+	CustomfieldID string `json:"customfield_id,omitempty"`
+	Label         string `json:"label,omitempty"`
+	Value         string `json:"value,omitempty"`
+}
+
+// CustomField is a custom field as returned on a document
+type CustomField struct {
+	CustomfieldID string `json:"customfield_id"`
+	DataType      string `json:"data_type"`
+	Index         int64  `json:"index"`
+	IsActive      bool   `json:"is_active"`
+	Label         string `json:"label"`
+	ShowOnPdf     bool   `json:"show_on_pdf"`
+	ShowInAllPdf  bool   `json:"show_in_all_pdf"`
+	Value         string `json:"value"`
+}
+
+// Request returns the custom field as it is provided when creating or updating a document
+func (f CustomField) Request() CustomFieldRequest {
+	return CustomFieldRequest{
+		CustomfieldID: f.CustomfieldID,
+		Label:         f.Label,
+		Value:         f.Value,
+	}
+}
+
+// Address is a billing or shipping address attached to a contact or document
+type Address struct {
+	AddressID string `json:"address_id,omitempty"`
+	Attention string `json:"attention,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Street2   string `json:"street2,omitempty"`
+	StateCode string `json:"state_code,omitempty"`
+	City      string `json:"city,omitempty"`
+	State     string `json:"state,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	Fax       string `json:"fax,omitempty"`
+}
+
+// LineItem is a single line of a sales or purchase document
+type LineItem struct {
+	LineItemID          string               `json:"line_item_id,omitempty"`
+	ItemID              string               `json:"item_id,omitempty"`
+	AccountID           string               `json:"account_id,omitempty"`
+	AccountName         string               `json:"account_name,omitempty"`
+	Name                string               `json:"name,omitempty"`
+	Description         string               `json:"description,omitempty"`
+	ItemType            string               `json:"item_type,omitempty"`
+	ProductType         string               `json:"product_type,omitempty"`
+	ItemOrder           float64              `json:"item_order,omitempty"`
+	BcyRate             *zoho.Money          `json:"bcy_rate,omitempty"`
+	Rate                *zoho.Money          `json:"rate,omitempty"`
+	Quantity            float64              `json:"quantity,omitempty"`
+	QuantityBilled      float64              `json:"quantity_billed,omitempty"` // read only
+	Unit                string               `json:"unit,omitempty"`
+	Discount            float64              `json:"discount,omitempty"`
+	DiscountAmount      *zoho.Money          `json:"discount_amount,omitempty"`
+	TaxID               string               `json:"tax_id,omitempty"`
+	TaxExemptionID      string               `json:"tax_exemption_id,omitempty"`
+	TaxName             string               `json:"tax_name,omitempty"`
+	TaxType             string               `json:"tax_type,omitempty"`
+	TaxPercentage       float64              `json:"tax_percentage,omitempty"`
+	ItemTotal           *zoho.Money          `json:"item_total,omitempty"`
+	ProjectID           string               `json:"project_id,omitempty"`
+	ProjectName         string               `json:"project_name,omitempty"`
+	TimeEntryIDs        []string             `json:"time_entry_ids,omitempty"`
+	ExpenseID           string               `json:"expense_id,omitempty"`
+	SalesorderItemID    string               `json:"salesorder_item_id,omitempty"`
+	PurchaseorderItemID string               `json:"purchaseorder_item_id,omitempty"`
+	HsnOrSac            string               `json:"hsn_or_sac,omitempty"`
+	ItemCustomFields    []CustomFieldRequest `json:"item_custom_fields,omitempty"`
+}
+
+// Request returns the line as it is provided when creating another document from it, the
+// identifiers of the line and the amounts computed by Zoho are left out
+func (l LineItem) Request() LineItem {
+	return LineItem{
+		ItemID:           l.ItemID,
+		AccountID:        l.AccountID,
+		Name:             l.Name,
+		Description:      l.Description,
+		ProductType:      l.ProductType,
+		ItemOrder:        l.ItemOrder,
+		Rate:             l.Rate,
+		Quantity:         l.Quantity,
+		Unit:             l.Unit,
+		Discount:         l.Discount,
+		TaxID:            l.TaxID,
+		TaxExemptionID:   l.TaxExemptionID,
+		ProjectID:        l.ProjectID,
+		HsnOrSac:         l.HsnOrSac,
+		ItemCustomFields: l.ItemCustomFields,
+	}
+}
+
+// TaxSummary is the total amount of a single tax applied to a document
+type TaxSummary struct {
+	TaxName   string     `json:"tax_name"`
+	TaxAmount zoho.Money `json:"tax_amount"`
+}
+
+// ContactPerson is a person who can be reached for a contact and receives its documents
+type ContactPerson struct {
+	ContactPersonID  string `json:"contact_person_id,omitempty"`
+	ContactID        string `json:"contact_id,omitempty"`
+	Salutation       string `json:"salutation,omitempty"`
+	FirstName        string `json:"first_name"`
+	LastName         string `json:"last_name"`
+	Email            string `json:"email"`
+	Phone            string `json:"phone"`
+	Mobile           string `json:"mobile,omitempty"`
+	Skype            string `json:"skype,omitempty"`
+	Designation      string `json:"designation,omitempty"`
+	Department       string `json:"department,omitempty"`
+	IsPrimaryContact bool   `json:"is_primary_contact,omitempty"`
+	EnablePortal     bool   `json:"enable_portal,omitempty"`
+	IsAddedInPortal  bool   `json:"is_added_in_portal,omitempty"`
+}
+
+// PaymentOptions lists the online payment gateways a customer can use to pay an invoice
+type PaymentOptions struct {
+	PaymentGateways []PaymentGateway `json:"payment_gateways,omitempty"`
+}
+
+// PaymentGateway is an online payment gateway configured for the organization
+type PaymentGateway struct {
+	AdditionalField1     string `json:"additional_field1,omitempty"`
+	Configured           bool   `json:"configured,omitempty"`
+	GatewayName          string `json:"gateway_name,omitempty"`
+	GatewayNameFormatted string `json:"gateway_name_formatted,omitempty"`
+}
+
+// CustomFieldRequests returns the custom fields as they are provided when creating or updating a document
+func CustomFieldRequests(fields []CustomField) []CustomFieldRequest {
+	requests := make([]CustomFieldRequest, 0, len(fields))
+	for _, f := range fields {
+		requests = append(requests, f.Request())
+	}
+	return requests
+}
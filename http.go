@@ -12,6 +12,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/schmorrison/go-querystring/query"
@@ -28,6 +29,17 @@ type Endpoint struct {
 	Headers       map[string]string
 	BodyFormat    BodyFormat
 	Attachment    string
+
+	// AttachmentReader is streamed as the file contents in place of the file at Attachment when set,
+	// AttachmentName is the file name reported to Zoho and AttachmentField is the form field
+	// used for the file (defaults to "attachment")
+	AttachmentReader io.Reader
+	AttachmentName   string
+	AttachmentField  string
+
+	// ResponseWriter receives the raw body of a successful response in place of
+	// unmarshalling it into ResponseData, used for downloading files
+	ResponseWriter io.Writer
 }
 
 // Parameter is used to provide URL Parameters to zoho endpoints
@@ -39,6 +51,8 @@ const (
 	JSON_STRING = "jsonString"
 	FILE        = "file"
 	URL         = "url" // Added new BodyFormat option
+	// FORM sends the fields of RequestBody, named by their url tags, as a multipart form
+	FORM = "form"
 )
 
 // HTTPRequest is the function which actually performs the request to a Zoho endpoint as specified by the provided endpoint
@@ -85,7 +99,18 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 		contentType = "application/json; charset=UTF-8"
 	}
 
-	if endpoint.BodyFormat == JSON_STRING || endpoint.BodyFormat == FILE {
+	if endpoint.BodyFormat == FILE && endpoint.AttachmentReader != nil {
+		// Stream the file contents into a multipart form as the request is sent
+		pr, pw := io.Pipe()
+		defer pr.Close()
+		w := multipart.NewWriter(pw)
+		go func() {
+			pw.CloseWithError(writeAttachment(w, endpoint))
+		}()
+
+		reqBody = pr
+		contentType = w.FormDataContentType()
+	} else if endpoint.BodyFormat == JSON_STRING || endpoint.BodyFormat == FILE {
 		// Create a multipart form
 		var b bytes.Buffer
 		w := multipart.NewWriter(&b)
@@ -116,7 +141,7 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 			}
 			defer fileReader.Close()
 			// Create the correct form field
-			part, err := w.CreateFormFile("attachment", filepath.Base(endpoint.Attachment))
+			part, err := w.CreateFormFile(attachmentField(endpoint), filepath.Base(endpoint.Attachment))
 			if err != nil {
 				return err
 			}
@@ -146,6 +171,36 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 		contentType = "application/x-www-form-urlencoded; charset=UTF-8"
 	}
 
+	// Multipart form fields, such as a link sent in place of a file
+	if endpoint.BodyFormat == FORM {
+		values, err := query.Values(endpoint.RequestBody)
+		if err != nil {
+			return err
+		}
+
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		var b bytes.Buffer
+		w := multipart.NewWriter(&b)
+		for _, k := range keys {
+			for _, v := range values[k] {
+				if err := w.WriteField(k, v); err != nil {
+					return err
+				}
+			}
+		}
+		if err := w.Close(); err != nil {
+			return err
+		}
+
+		reqBody = &b
+		contentType = w.FormDataContentType()
+	}
+
 	req, err = http.NewRequest(string(endpoint.Method), fmt.Sprintf("%s?%s", endpointURL, q.Encode()), reqBody)
 	if err != nil {
 		return fmt.Errorf("Failed to create a request for %s: %s", endpoint.Name, err)
@@ -168,6 +223,14 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 
 	defer resp.Body.Close()
 
+	// Stream successful responses directly to the writer, such as when downloading a file
+	if endpoint.ResponseWriter != nil && resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if _, err = io.Copy(endpoint.ResponseWriter, resp.Body); err != nil {
+			return fmt.Errorf("Failed to read body of response for %s: got status %s: %s", endpoint.Name, resolveStatus(resp), err)
+		}
+		return nil
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return fmt.Errorf("Failed to read body of response for %s: got status %s: %s", endpoint.Name, resolveStatus(resp), err)
@@ -193,6 +256,31 @@ func (z *Zoho) HTTPRequest(endpoint *Endpoint) (err error) {
 	return nil
 }
 
+// attachmentField returns the form field name used for the file of endpoint
+func attachmentField(endpoint *Endpoint) string {
+	if endpoint.AttachmentField != "" {
+		return endpoint.AttachmentField
+	}
+	return "attachment"
+}
+
+// writeAttachment copies the AttachmentReader of endpoint into the multipart form w
+func writeAttachment(w *multipart.Writer, endpoint *Endpoint) error {
+	name := endpoint.AttachmentName
+	if name == "" {
+		name = filepath.Base(endpoint.Attachment)
+	}
+
+	part, err := w.CreateFormFile(attachmentField(endpoint), name)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(part, endpoint.AttachmentReader); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
 // HTTPStatusCode is a type for resolving the returned HTTP Status Code Content
 type HTTPStatusCode int
 
@@ -0,0 +1,110 @@
+// Package zohotest provides a fake Zoho API server for the tests of the product packages
+package zohotest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+// Server is a fake Zoho API answering the requests of the routes registered with Handle, any
+// other request fails the test
+//
+//	s := zohotest.NewServer(t)
+//	defer s.Close()
+//	s.Handle(http.MethodGet, "/crm/v2/Leads/1", zohotest.Reply(`{"data":[{"id":"1"}]}`))
+//	api := crm.New(s.Client())
+type Server struct {
+	t      *testing.T
+	server *httptest.Server
+	url    *url.URL
+
+	mu     sync.Mutex
+	routes map[string]http.HandlerFunc
+}
+
+// NewServer starts a Server failing t on unexpected requests, it must be closed with Close
+func NewServer(t *testing.T) *Server {
+	s := &Server{t: t, routes: map[string]http.HandlerFunc{}}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	var err error
+	if s.url, err = url.Parse(s.server.URL); err != nil {
+		s.server.Close()
+		t.Fatal(err)
+	}
+	return s
+}
+
+// Handle routes the requests of method on path to fn, replacing any previous route
+func (s *Server) Handle(method, path string, fn http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[method+" "+path] = fn
+}
+
+// Client returns a Zoho client holding a valid access token, whose requests are sent to s
+// whatever their domain
+func (s *Server) Client() *zoho.Zoho {
+	z := zoho.New()
+	z.SetTokenManager(tokens{})
+	z.CustomHTTPClient(&http.Client{Transport: transport{server: s.url}})
+	return z
+}
+
+// Close shuts the server down
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	fn, ok := s.routes[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if !ok {
+		s.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		http.NotFound(w, r)
+		return
+	}
+	fn(w, r)
+}
+
+// Reply returns a handler answering every request with body
+func Reply(body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}
+}
+
+// DecodeJSON decodes the JSON body of r into v, failing t when it is invalid
+func DecodeJSON(t *testing.T, r *http.Request, v interface{}) {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		t.Errorf("Failed to decode %s %s: %s", r.Method, r.URL.Path, err)
+	}
+}
+
+// tokens provides a valid access token without persisting it
+type tokens struct{}
+
+func (tokens) SaveTokens(t zoho.AccessTokenResponse) error { return nil }
+
+func (tokens) LoadAccessAndRefreshToken() (zoho.AccessTokenResponse, error) {
+	return zoho.AccessTokenResponse{AccessToken: "token"}, nil
+}
+
+// transport sends every request to the test server
+type transport struct {
+	server *url.URL
+}
+
+func (t transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r.URL.Scheme = t.server.Scheme
+	r.URL.Host = t.server.Host
+	return http.DefaultTransport.RoundTrip(r)
+}
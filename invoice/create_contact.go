@@ -121,34 +121,34 @@ type CreateContactResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 	Contact struct {
-		ContactID                        string  `json:"contact_id"`
-		ContactName                      string  `json:"contact_name"`
-		CompanyName                      string  `json:"company_name"`
-		HasTransaction                   bool    `json:"has_transaction"`
-		ContactType                      string  `json:"contact_type"`
-		IsTaxable                        bool    `json:"is_taxable"`
-		TaxID                            string  `json:"tax_id"`
-		TaxName                          string  `json:"tax_name"`
-		TaxPercentage                    float64 `json:"tax_percentage"`
-		TaxExemptionID                   string  `json:"tax_exemption_id"`
-		TaxAuthorityID                   string  `json:"tax_authority_id"`
-		GSTNo                            string  `json:"gst_no"`
-		GSTTreatment                     string  `json:"gst_treatment"`
-		IsLinkedWithZohocrm              bool    `json:"is_linked_with_zohocrm"`
-		Website                          string  `json:"website"`
-		PrimaryContactID                 string  `json:"primary_contact_id"`
-		PaymentTerms                     int64   `json:"payment_terms"`
-		PaymentTermsLabel                string  `json:"payment_terms_label"`
-		CurrencyID                       string  `json:"currency_id"`
-		CurrencyCode                     string  `json:"currency_code"`
-		CurrencySymbol                   string  `json:"currency_symbol"`
-		LanguageCode                     string  `json:"language_code"`
-		OutstandingReceivableAmount      float64 `json:"outstanding_receivable_amount"`
-		OutstandingReceivableAmountBcy   float64 `json:"outstanding_receivable_amount_bcy"`
-		UnusedCreditsReceivableAmount    float64 `json:"unused_credits_receivable_amount"`
-		UnusedCreditsReceivableAmountBcy float64 `json:"unused_credits_receivable_amount_bcy"`
-		Status                           string  `json:"status"`
-		PaymentReminderEnabled           bool    `json:"payment_reminder_enabled"`
+		ContactID                        string     `json:"contact_id"`
+		ContactName                      string     `json:"contact_name"`
+		CompanyName                      string     `json:"company_name"`
+		HasTransaction                   bool       `json:"has_transaction"`
+		ContactType                      string     `json:"contact_type"`
+		IsTaxable                        bool       `json:"is_taxable"`
+		TaxID                            string     `json:"tax_id"`
+		TaxName                          string     `json:"tax_name"`
+		TaxPercentage                    float64    `json:"tax_percentage"`
+		TaxExemptionID                   string     `json:"tax_exemption_id"`
+		TaxAuthorityID                   string     `json:"tax_authority_id"`
+		GSTNo                            string     `json:"gst_no"`
+		GSTTreatment                     string     `json:"gst_treatment"`
+		IsLinkedWithZohocrm              bool       `json:"is_linked_with_zohocrm"`
+		Website                          string     `json:"website"`
+		PrimaryContactID                 string     `json:"primary_contact_id"`
+		PaymentTerms                     int64      `json:"payment_terms"`
+		PaymentTermsLabel                string     `json:"payment_terms_label"`
+		CurrencyID                       string     `json:"currency_id"`
+		CurrencyCode                     string     `json:"currency_code"`
+		CurrencySymbol                   string     `json:"currency_symbol"`
+		LanguageCode                     string     `json:"language_code"`
+		OutstandingReceivableAmount      zoho.Money `json:"outstanding_receivable_amount"`
+		OutstandingReceivableAmountBcy   zoho.Money `json:"outstanding_receivable_amount_bcy"`
+		UnusedCreditsReceivableAmount    zoho.Money `json:"unused_credits_receivable_amount"`
+		UnusedCreditsReceivableAmountBcy zoho.Money `json:"unused_credits_receivable_amount_bcy"`
+		Status                           string     `json:"status"`
+		PaymentReminderEnabled           bool       `json:"payment_reminder_enabled"`
 		CustomFields                     []struct {
 			Value string `json:"value"`
 			Index int64  `json:"index"`
@@ -161,35 +161,11 @@ type CreateContactResponse struct {
 		ContactPersons   []ContactPerson         `json:"contact_persons"`
 		DefaultTemplates ContactDefaultTemplates `json:"default_templates"`
 		Notes            string                  `json:"notes"`
-		CreatedTime      string                  `json:"created_time"`
-		LastModifiedTime string                  `json:"last_modified_time"`
+		CreatedTime      zoho.Time               `json:"created_time"`
+		LastModifiedTime zoho.Time               `json:"last_modified_time"`
 	} `json:"contact"`
 }
 
-type ContactPerson struct {
-	ContactPersonID  string `json:"contact_person_id,omitempty"`
-	Salutation       string `json:"salutation,omitempty"`
-	FirstName        string `json:"first_name"`
-	LastName         string `json:"last_name"`
-	Email            string `json:"email"`
-	Phone            string `json:"phone"`
-	Mobile           string `json:"mobile,omitempty"`
-	IsPrimaryContact bool   `json:"is_primary_contact,omitempty"`
-}
-
-type ContactAddress struct {
-	Attention string `json:"attention,omitempty"`
-	Address   string `json:"address,omitempty"`
-	Street2   string `json:"street2,omitempty"`
-	StateCode string `json:"state_code,omitempty"`
-	City      string `json:"city,omitempty"`
-	State     string `json:"state,omitempty"`
-	Zip       string `json:"zip,omitempty"`
-	Country   string `json:"country,omitempty"`
-	Fax       string `json:"fax,omitempty"`
-	Phone     string `json:"phone,omitempty"`
-}
-
 type ContactDefaultTemplates struct {
 	InvoiceTemplateID           string `json:"invoice_template_id,omitempty"`
 	InvoiceTemplateName         string `json:"invoice_template_name,omitempty"`
@@ -75,10 +75,10 @@ type CreateInvoiceRequest struct {
 	GstTreatment          string               `json:"gst_treatment,omitempty"`
 	GstNo                 string               `json:"gst_no,omitempty"`
 	TemplateId            string               `json:"template_id,omitempty"`
-	Date                  string               `json:"date,omitempty"`
+	Date                  *zoho.Date           `json:"date,omitempty"`
 	PaymentTerms          int64                `json:"payment_terms,omitempty"`
 	PaymentTermsLabel     string               `json:"payment_terms_label,omitempty"`
-	DueDate               string               `json:"due_date,omitempty"`
+	DueDate               *zoho.Date           `json:"due_date,omitempty"`
 	Discount              float64              `json:"discount,omitempty"`
 	IsDiscountBeforeTax   bool                 `json:"is_discount_before_tax,omitempty"`
 	DiscountType          string               `json:"discount_type,omitempty"`
@@ -96,8 +96,8 @@ type CreateInvoiceRequest struct {
 	CustomSubject         string               `json:"custom_subject,omitempty"`
 	Notes                 string               `json:"notes,omitempty"`
 	Terms                 string               `json:"terms,omitempty"`
-	ShippingCharge        float64              `json:"shipping_charge,omitempty"`
-	Adjustment            float64              `json:"adjustment,omitempty"`
+	ShippingCharge        *zoho.Money          `json:"shipping_charge,omitempty"`
+	Adjustment            *zoho.Money          `json:"adjustment,omitempty"`
 	AdjustmentDescription string               `json:"adjustment_description"`
 	Reason                string               `json:"reason,omitempty"`
 	TaxAuthorityId        string               `json:"tax_authority_id,omitempty"`
@@ -113,107 +113,68 @@ type CreateInvoiceResponse struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
 	Invoice struct {
-		InvoiceId             string            `json:"invoice_id"`
-		AchPaymentInitiated   bool              `json:"ach_payment_initiated"`
-		InvoiceNumber         string            `json:"invoice_number"`
-		IsPreGst              bool              `json:"is_pre_gst"`
-		PlaceOfSupply         string            `json:"place_of_supply"`
-		GstNo                 string            `json:"gst_no"`
-		GstTreatment          string            `json:"gst_treatment"`
-		Date                  string            `json:"date"`
-		Status                string            `json:"status"`
-		PaymentTerms          int64             `json:"payment_terms"`
-		PaymentTermsLabel     string            `json:"payment_terms_label"`
-		DueDate               string            `json:"due_date"`
-		PaymentExpectedDate   string            `json:"payment_expected_date"`
-		LastPaymentDate       string            `json:"last_payment_date"`
-		ReferenceNumber       string            `json:"reference_number"`
-		CustomerId            string            `json:"customer_id"`
-		CustomerName          string            `json:"customer_name"`
-		ContactPersons        []string          `json:"contact_persons"`
-		CurrencyId            string            `json:"currency_id"`
-		CurrencyCode          string            `json:"currency_code"`
-		ExchangeRate          float64           `json:"exchange_rate"`
-		Discount              float64           `json:"discount"`
-		IsDiscountBeforeTax   bool              `json:"is_discount_before_tax"`
-		DiscountType          string            `json:"discount_type"`
-		IsInclusiveTax        bool              `json:"is_inclusive_tax"`
-		RecurringInvoiceId    string            `json:"recurring_invoice_id"`
-		IsViewedByClient      bool              `json:"is_viewed_by_client"`
-		HasAttachment         bool              `json:"has_attachment"`
-		ClientViewedTime      string            `json:"client_viewed_time"`
-		LineItems             []InvoiceLineItem `json:"line_items"`
-		ShippingCharge        float64           `json:"shipping_charge"`
-		Adjustment            float64           `json:"adjustment"`
-		AdjustmentDescription string            `json:"adjustment_description"`
-		SubTotal              float64           `json:"sub_total"`
-		TaxTotal              float64           `json:"tax_total"`
-		Total                 float64           `json:"total"`
-		Taxes                 []struct {
-			TaxName   string  `json:"tax_name"`
-			TaxAmount float64 `json:"tax_amount"`
-		} `json:"taxes"`
-		PaymentReminderEnabled bool           `json:"payment_reminder_enabled"`
-		PaymentMade            float64        `json:"payment_made"`
-		CreditsApplied         float64        `json:"credits_applied"`
-		TaxAmountWithheld      float64        `json:"tax_amount_withheld"`
-		Balance                float64        `json:"balance"`
-		WriteOffAmount         float64        `json:"write_off_amount"`
-		AllowPartialPayments   bool           `json:"allow_partial_payments"`
-		PricePrecision         int64          `json:"price_precision"`
-		PaymentOptions         PaymentOptions `json:"payment_options"`
-		IsEmailed              bool           `json:"is_emailed"`
-		RemindersSent          int64          `json:"reminders_sent"`
-		LastReminderSentDate   string         `json:"last_reminder_sent_date"`
-		BillingAddress         ContactAddress `json:"billing_address"`
-		ShippingAddress        ContactAddress `json:"shipping_address"`
-		Notes                  string         `json:"notes"`
-		Terms                  string         `json:"terms"`
-		CustomFields           []struct {
-			CustomfieldId string `json:"customfield_id"`
-			DataType      string `json:"data_type"`
-			Index         int64  `json:"index"`
-			Label         string `json:"label"`
-			ShowOnPdf     bool   `json:"show_on_pdf"`
-			ShowInAllPdf  bool   `json:"show_in_all_pdf"`
-			Value         string `json:"value"`
-		} `json:"custom_fields"`
-		TemplateId       string `json:"template_id"`
-		TemplateName     string `json:"template_name"`
-		CreatedTime      string `json:"created_time"`
-		LastModifiedTime string `json:"last_modified_time"`
-		AttachmentName   string `json:"attachment_name"`
-		CanSendInMail    bool   `json:"can_send_in_mail"`
-		SalespersonId    string `json:"salesperson_id"`
-		SalespersonName  string `json:"salesperson_name"`
-		InvoiceUrl       string `json:"invoice_url"`
+		InvoiceId              string            `json:"invoice_id"`
+		AchPaymentInitiated    bool              `json:"ach_payment_initiated"`
+		InvoiceNumber          string            `json:"invoice_number"`
+		IsPreGst               bool              `json:"is_pre_gst"`
+		PlaceOfSupply          string            `json:"place_of_supply"`
+		GstNo                  string            `json:"gst_no"`
+		GstTreatment           string            `json:"gst_treatment"`
+		Date                   zoho.Date         `json:"date"`
+		Status                 string            `json:"status"`
+		PaymentTerms           int64             `json:"payment_terms"`
+		PaymentTermsLabel      string            `json:"payment_terms_label"`
+		DueDate                zoho.Date         `json:"due_date"`
+		PaymentExpectedDate    zoho.Date         `json:"payment_expected_date"`
+		LastPaymentDate        zoho.Date         `json:"last_payment_date"`
+		ReferenceNumber        string            `json:"reference_number"`
+		CustomerId             string            `json:"customer_id"`
+		CustomerName           string            `json:"customer_name"`
+		ContactPersons         []string          `json:"contact_persons"`
+		CurrencyId             string            `json:"currency_id"`
+		CurrencyCode           string            `json:"currency_code"`
+		ExchangeRate           float64           `json:"exchange_rate"`
+		Discount               float64           `json:"discount"`
+		IsDiscountBeforeTax    bool              `json:"is_discount_before_tax"`
+		DiscountType           string            `json:"discount_type"`
+		IsInclusiveTax         bool              `json:"is_inclusive_tax"`
+		RecurringInvoiceId     string            `json:"recurring_invoice_id"`
+		IsViewedByClient       bool              `json:"is_viewed_by_client"`
+		HasAttachment          bool              `json:"has_attachment"`
+		ClientViewedTime       zoho.Time         `json:"client_viewed_time"`
+		LineItems              []InvoiceLineItem `json:"line_items"`
+		ShippingCharge         zoho.Money        `json:"shipping_charge"`
+		Adjustment             zoho.Money        `json:"adjustment"`
+		AdjustmentDescription  string            `json:"adjustment_description"`
+		SubTotal               zoho.Money        `json:"sub_total"`
+		TaxTotal               zoho.Money        `json:"tax_total"`
+		Total                  zoho.Money        `json:"total"`
+		Taxes                  []TaxSummary      `json:"taxes"`
+		PaymentReminderEnabled bool              `json:"payment_reminder_enabled"`
+		PaymentMade            zoho.Money        `json:"payment_made"`
+		CreditsApplied         zoho.Money        `json:"credits_applied"`
+		TaxAmountWithheld      zoho.Money        `json:"tax_amount_withheld"`
+		Balance                zoho.Money        `json:"balance"`
+		WriteOffAmount         zoho.Money        `json:"write_off_amount"`
+		AllowPartialPayments   bool              `json:"allow_partial_payments"`
+		PricePrecision         int64             `json:"price_precision"`
+		PaymentOptions         PaymentOptions    `json:"payment_options"`
+		IsEmailed              bool              `json:"is_emailed"`
+		RemindersSent          int64             `json:"reminders_sent"`
+		LastReminderSentDate   zoho.Date         `json:"last_reminder_sent_date"`
+		BillingAddress         ContactAddress    `json:"billing_address"`
+		ShippingAddress        ContactAddress    `json:"shipping_address"`
+		Notes                  string            `json:"notes"`
+		Terms                  string            `json:"terms"`
+		CustomFields           []CustomField     `json:"custom_fields"`
+		TemplateId             string            `json:"template_id"`
+		TemplateName           string            `json:"template_name"`
+		CreatedTime            zoho.Time         `json:"created_time"`
+		LastModifiedTime       zoho.Time         `json:"last_modified_time"`
+		AttachmentName         string            `json:"attachment_name"`
+		CanSendInMail          bool              `json:"can_send_in_mail"`
+		SalespersonId          string            `json:"salesperson_id"`
+		SalespersonName        string            `json:"salesperson_name"`
+		InvoiceUrl             string            `json:"invoice_url"`
 	} `json:"invoice"`
 }
-
-type InvoiceLineItem struct {
-	LineItemId       string               `json:"line_item_id,omitempty"`
-	ItemId           string               `json:"item_id,omitempty"`
-	Description      string               `json:"description,omitempty"`
-	ProjectId        string               `json:"project_id,omitempty"`
-	ProjectName      string               `json:"project_name,omitempty"`
-	TimeEntryIds     []string             `json:"time_entry_ids,omitempty"`
-	ItemType         string               `json:"item_type,omitempty"`
-	ProductType      string               `json:"product_type,omitempty"`
-	ExpenseId        string               `json:"expense_id,omitempty"`
-	Name             string               `json:"name,omitempty"`
-	ItemOrder        float64              `json:"item_order,omitempty"`
-	BcyRate          float64              `json:"bcy_rate,omitempty"`
-	Rate             float64              `json:"rate,omitempty"`
-	Quantity         int64                `json:"quantity,omitempty"`
-	Unit             string               `json:"unit,omitempty"`
-	DiscountAmount   float64              `json:"discount_amount,omitempty"`
-	Discount         float64              `json:"discount,omitempty"`
-	TaxId            string               `json:"tax_id,omitempty"`
-	TaxExemptionId   string               `json:"tax_exemption_id,omitempty"`
-	TaxName          string               `json:"tax_name,omitempty"`
-	TaxType          string               `json:"tax_type,omitempty"`
-	TaxPercentage    float64              `json:"tax_percentage,omitempty"`
-	ItemTotal        float64              `json:"item_total,omitempty"`
-	HsnOrSac         int64                `json:"hsn_or_sac,omitempty"`
-	ItemCustomFields []CustomFieldRequest `json:"item_custom_fields,omitempty"`
-}
@@ -47,13 +47,13 @@ func (c *API) CreatePayment(request interface{}) (data CreatePaymentResponse, er
 type CreatePaymentRequest struct {
 	CustomerId      string                 `json:"customer_id"`
 	PaymentMode     string                 `json:"payment_mode"`
-	Amount          float64                `json:"amount"`
-	Date            string                 `json:"date"`
+	Amount          zoho.Money             `json:"amount"`
+	Date            zoho.Date              `json:"date"`
 	ReferenceNumber string                 `json:"reference_number"`
 	Description     string                 `json:"description"`
 	Invoices        []CreatePaymentInvoice `json:"invoices"`
 	ExchangeRate    float64                `json:"exchange_rate"`
-	BankCharges     float64                `json:"bank_charges"`
+	BankCharges     zoho.Money             `json:"bank_charges"`
 	CustomFields    []struct {
 		Label string `json:"label"`
 		Value string `json:"value"`
@@ -61,33 +61,33 @@ type CreatePaymentRequest struct {
 }
 
 type CreatePaymentInvoice struct {
-	CustomerId    string  `json:"invoice_id"`
-	AmountApplied float64 `json:"amount_applied"`
+	CustomerId    string     `json:"invoice_id"`
+	AmountApplied zoho.Money `json:"amount_applied"`
 }
 
 type CreatePaymentResponse struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
 	Payment struct {
-		PaymentId       string  `json:"payment_id"`
-		PaymentMode     string  `json:"payment_mode"`
-		Amount          float64 `json:"amount"`
-		AmountRefunded  float64 `json:"amount_refunded"`
-		BankCharges     float64 `json:"bank_charges"`
-		Date            float64 `json:"date"`
-		Status          string  `json:"status"`
-		ReferenceNumber string  `json:"reference_number"`
-		CustomerId      string  `json:"customer_id"`
-		CustomerName    string  `json:"customer_name"`
-		Email           string  `json:"email"`
+		PaymentId       string     `json:"payment_id"`
+		PaymentMode     string     `json:"payment_mode"`
+		Amount          zoho.Money `json:"amount"`
+		AmountRefunded  zoho.Money `json:"amount_refunded"`
+		BankCharges     zoho.Money `json:"bank_charges"`
+		Date            float64    `json:"date"`
+		Status          string     `json:"status"`
+		ReferenceNumber string     `json:"reference_number"`
+		CustomerId      string     `json:"customer_id"`
+		CustomerName    string     `json:"customer_name"`
+		Email           string     `json:"email"`
 		Invoices        []struct {
-			InvoiceId        string  `json:"invoice_id"`
-			InvoicePaymentId string  `json:"invoice_payment_id"`
-			InvoiceNumber    string  `json:"invoice_number"`
-			Date             string  `json:"date"`
-			InvoiceAmount    float64 `json:"invoice_amount"`
-			AmountApplied    float64 `json:"amount_applied"`
-			BalanceAmount    float64 `json:"balance_amount"`
+			InvoiceId        string     `json:"invoice_id"`
+			InvoicePaymentId string     `json:"invoice_payment_id"`
+			InvoiceNumber    string     `json:"invoice_number"`
+			Date             zoho.Date  `json:"date"`
+			InvoiceAmount    zoho.Money `json:"invoice_amount"`
+			AmountApplied    zoho.Money `json:"amount_applied"`
+			BalanceAmount    zoho.Money `json:"balance_amount"`
 		}
 		CurrencyCode   string `json:"currency_code"`
 		CurrencySymbol string `json:"currency_symbol"`
@@ -60,49 +60,49 @@ type RecurringInvoiceResponse struct {
 	Code             int64  `json:"code"`
 	Message          string `json:"message"`
 	RecurringInvoice struct {
-		RecurringInvoiceId  string `json:"recurring_invoice_id"`
-		RecurrenceName      string `json:"recurrence_name"`
-		ReferenceNumber     string `json:"reference_number"`
-		CustomerName        string `json:"customer_name"`
-		CustomerId          string `json:"customer_id"`
-		IsPreGst            bool   `json:"is_pre_gst"`
-		GstNo               string `json:"gst_no"`
-		GstTreatment        string `json:"gst_treatment"`
-		PlaceOfSupply       string `json:"place_of_supply"`
-		RecurrenceFrequency string `json:"recurrence_frequency"`
-		CompanyName         string `json:"company_name"`
-		CustomerEmail       string `json:"customer_email"`
-		CustomerMobilePhone string `json:"customer_mobile_phone"`
-		CustomerPhone       string `json:"customer_phone"`
-		PhotoUrl            string `json:"photo_url"`
-		CurrencyId          string `json:"currency_id"`
-		CurrencyCode        string `json:"currency_code"`
-		StartDate           string `json:"start_date"`
-		EndDate             string `json:"end_date"`
-		LastSentDate        string `json:"last_sent_date"`
-		NextInvoiceDate     string `json:"next_invoice_date"`
+		RecurringInvoiceId  string    `json:"recurring_invoice_id"`
+		RecurrenceName      string    `json:"recurrence_name"`
+		ReferenceNumber     string    `json:"reference_number"`
+		CustomerName        string    `json:"customer_name"`
+		CustomerId          string    `json:"customer_id"`
+		IsPreGst            bool      `json:"is_pre_gst"`
+		GstNo               string    `json:"gst_no"`
+		GstTreatment        string    `json:"gst_treatment"`
+		PlaceOfSupply       string    `json:"place_of_supply"`
+		RecurrenceFrequency string    `json:"recurrence_frequency"`
+		CompanyName         string    `json:"company_name"`
+		CustomerEmail       string    `json:"customer_email"`
+		CustomerMobilePhone string    `json:"customer_mobile_phone"`
+		CustomerPhone       string    `json:"customer_phone"`
+		PhotoUrl            string    `json:"photo_url"`
+		CurrencyId          string    `json:"currency_id"`
+		CurrencyCode        string    `json:"currency_code"`
+		StartDate           zoho.Date `json:"start_date"`
+		EndDate             zoho.Date `json:"end_date"`
+		LastSentDate        zoho.Date `json:"last_sent_date"`
+		NextInvoiceDate     zoho.Date `json:"next_invoice_date"`
 		LineItems           []struct {
-			LineItemId       string  `json:"line_item_id"`
-			ItemId           string  `json:"item_id"`
-			ItemOrder        float64 `json:"item_order"`
-			DiscountAmount   float64 `json:"discount_amount"`
-			Quantity         int64   `json:"quantity"`
-			Rate             float64 `json:"rate"`
-			Discount         float64 `json:"discount"`
-			Name             string  `json:"name"`
-			ItemTotal        float64 `json:"item_total"`
-			Sku              string  `json:"sku"`
-			ProductType      string  `json:"product_type"`
-			ProjectId        string  `json:"project_id"`
-			ProjectName      string  `json:"project_name"`
+			LineItemId       string     `json:"line_item_id"`
+			ItemId           string     `json:"item_id"`
+			ItemOrder        float64    `json:"item_order"`
+			DiscountAmount   zoho.Money `json:"discount_amount"`
+			Quantity         int64      `json:"quantity"`
+			Rate             zoho.Money `json:"rate"`
+			Discount         float64    `json:"discount"`
+			Name             string     `json:"name"`
+			ItemTotal        zoho.Money `json:"item_total"`
+			Sku              string     `json:"sku"`
+			ProductType      string     `json:"product_type"`
+			ProjectId        string     `json:"project_id"`
+			ProjectName      string     `json:"project_name"`
 			ItemCustomFields []struct {
 				CustomfieldID string `json:"customfield_id,omitempty"`
 				Label         string `json:"label"`
 				Value         string `json:"value,omitempty"`
 			} `json:"item_custom_fields"`
 		} `json:"line_items"`
-		PaidInvoicesTotal     float64        `json:"paid_invoices_total"`
-		UnpaidInvoicesBalance float64        `json:"unpaid_invoices_balance"`
+		PaidInvoicesTotal     zoho.Money     `json:"paid_invoices_total"`
+		UnpaidInvoicesBalance zoho.Money     `json:"unpaid_invoices_balance"`
 		BillingAddress        ContactAddress `json:"billing_address"`
 		ShippingAddress       ContactAddress `json:"shipping_address"`
 		/*CustomFields []struct {
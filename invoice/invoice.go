@@ -4,6 +4,7 @@ import (
 	"math/rand"
 
 	zoho "github.com/schmorrison/Zoho"
+	"github.com/schmorrison/Zoho/finance"
 )
 
 const (
@@ -17,11 +18,20 @@ const (
 	CustomerPaymentsModule   string = "customerpayments"
 )
 
-type CustomFieldRequest struct {
-	CustomfieldID string `json:"customfield_id,omitempty"`
-	Label         string `json:"label"`
-	Value         string `json:"value,omitempty"`
-}
+// Document shapes shared with the books package, so that documents can be moved between Zoho
+// Invoice and Zoho Books without conversion. Their fields follow the Go naming of the finance
+// package (ItemID, LineItemID, CustomfieldID...), quantities are fractional and HSN/SAC codes
+// are strings.
+type (
+	CustomField        = finance.CustomField
+	CustomFieldRequest = finance.CustomFieldRequest
+	ContactAddress     = finance.Address
+	InvoiceLineItem    = finance.LineItem
+	TaxSummary         = finance.TaxSummary
+	ContactPerson      = finance.ContactPerson
+	PaymentOptions     = finance.PaymentOptions
+	PaymentGateway     = finance.PaymentGateway
+)
 
 // API is used for interacting with the Zoho expense API
 // the exposed methods are primarily access to expense modules which provide access to expense Methods
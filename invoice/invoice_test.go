@@ -0,0 +1,56 @@
+package invoice
+
+import (
+	"encoding/json"
+	"testing"
+
+	zoho "github.com/schmorrison/Zoho"
+)
+
+func TestInvoiceLineItemJSON(t *testing.T) {
+	rate := zoho.NewMoney(1250, 2, "")
+	item := InvoiceLineItem{
+		LineItemID:   "1",
+		ItemID:       "2",
+		ProjectID:    "3",
+		TimeEntryIDs: []string{"4"},
+		ExpenseID:    "5",
+		Rate:         &rate,
+		Quantity:     2.5,
+		TaxID:        "6",
+		HsnOrSac:     "9983A",
+		ItemCustomFields: []CustomFieldRequest{
+			{CustomfieldID: "7", Value: "x"},
+		},
+	}
+	want := `{"line_item_id":"1","item_id":"2","rate":12.50,"quantity":2.5,"tax_id":"6",` +
+		`"project_id":"3","time_entry_ids":["4"],"expense_id":"5","hsn_or_sac":"9983A",` +
+		`"item_custom_fields":[{"customfield_id":"7","value":"x"}]}`
+
+	b, err := json.Marshal(item)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %s", err)
+	}
+	if string(b) != want {
+		t.Errorf("Marshal = %s, want %s", b, want)
+	}
+}
+
+func TestCreateInvoiceRequestJSON(t *testing.T) {
+	request := CreateInvoiceRequest{
+		CustomerId:   "1",
+		CustomFields: []CustomFieldRequest{{Label: "PO", Value: "42"}},
+		LineItems:    []InvoiceLineItem{{ItemID: "2", Quantity: 1}},
+	}
+	want := `{"customer_id":"1","custom_fields":[{"label":"PO","value":"42"}],` +
+		`"line_items":[{"item_id":"2","quantity":1}],"payment_options":{},` +
+		`"allow_partial_payments":false,"adjustment_description":""}`
+
+	b, err := json.Marshal(request)
+	if err != nil {
+		t.Fatalf("Marshal returned error: %s", err)
+	}
+	if string(b) != want {
+		t.Errorf("Marshal = %s, want %s", b, want)
+	}
+}
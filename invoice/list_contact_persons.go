@@ -54,21 +54,7 @@ func (c *API) ListContactPersons() (data ListContactPersonsResponse, err error)
 }
 
 type ListContactPersonsResponse struct {
-	Code           int    `json:"code"`
-	Message        string `json:"message"`
-	ContactPersons []struct {
-		ContactID        string `json:"contact_id"`
-		ContactPersonID  string `json:"contact_person_id"`
-		Salutation       string `json:"salutation"`
-		FirstName        string `json:"first_name"`
-		LastName         string `json:"last_name"`
-		Email            string `json:"email"`
-		Phone            string `json:"phone,omitempty"`
-		Mobile           string `json:"mobile,omitempty"`
-		IsPrimaryContact bool   `json:"is_primary_contact"`
-		Skype            string `json:"skype,omitempty"`
-		Designation      string `json:"designation,omitempty"`
-		Department       string `json:"department,omitempty"`
-		IsAddedInPortal  bool   `json:"is_added_in_portal"`
-	} `json:"contact_persons"`
+	Code           int             `json:"code"`
+	Message        string          `json:"message"`
+	ContactPersons []ContactPerson `json:"contact_persons"`
 }
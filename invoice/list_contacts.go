@@ -49,24 +49,24 @@ type ListContactsResponse struct {
 	Code     int    `json:"code"`
 	Message  string `json:"message"`
 	Contacts []struct {
-		ContactID                     string  `json:"contact_id"`
-		ContactName                   string  `json:"contact_name"`
-		CompanyName                   string  `json:"company_name"`
-		ContactType                   string  `json:"contact_type"`
-		Status                        string  `json:"status"`
-		PaymentTerms                  int64   `json:"payment_terms"`
-		PaymentTermsLabel             string  `json:"payment_terms_label"`
-		CurrencyID                    string  `json:"currency_id"`
-		CurrencyCode                  string  `json:"currency_code"`
-		OutstandingReceivableAmount   float64 `json:"outstanding_receivable_amount"`
-		UnusedCreditsReceivableAmount float64 `json:"unused_credits_receivable_amount"`
-		FirstName                     string  `json:"first_name"`
-		LastName                      string  `json:"last_name"`
-		Email                         string  `json:"email"`
-		Phone                         string  `json:"phone"`
-		Mobile                        string  `json:"mobile"`
-		CreatedTime                   string  `json:"created_time"`
-		LastModifiedTime              string  `json:"last_modified_time"`
+		ContactID                     string     `json:"contact_id"`
+		ContactName                   string     `json:"contact_name"`
+		CompanyName                   string     `json:"company_name"`
+		ContactType                   string     `json:"contact_type"`
+		Status                        string     `json:"status"`
+		PaymentTerms                  int64      `json:"payment_terms"`
+		PaymentTermsLabel             string     `json:"payment_terms_label"`
+		CurrencyID                    string     `json:"currency_id"`
+		CurrencyCode                  string     `json:"currency_code"`
+		OutstandingReceivableAmount   zoho.Money `json:"outstanding_receivable_amount"`
+		UnusedCreditsReceivableAmount zoho.Money `json:"unused_credits_receivable_amount"`
+		FirstName                     string     `json:"first_name"`
+		LastName                      string     `json:"last_name"`
+		Email                         string     `json:"email"`
+		Phone                         string     `json:"phone"`
+		Mobile                        string     `json:"mobile"`
+		CreatedTime                   zoho.Time  `json:"created_time"`
+		LastModifiedTime              zoho.Time  `json:"last_modified_time"`
 		/*CustomFields  []struct {
 			CustomfieldID string `json:"customfield_id"`
 			Label         string `json:"label"`
@@ -57,12 +57,12 @@ type ListCustomerPaymentsResponse struct {
 	Code             int    `json:"code"`
 	Message          string `json:"message"`
 	CustomerPayments []struct {
-		PaymentId     string  `json:"payment_id"`
-		PaymentNumber string  `json:"payment_number"`
-		InvoiceNumber string  `json:"invoice_number"`
-		Date          string  `json:"date"`
-		PaymentMode   string  `json:"payment_mode"`
-		Amount        float64 `json:"amount"`
-		BcyAmount     float64 `json:"bcy_amount"`
+		PaymentId     string     `json:"payment_id"`
+		PaymentNumber string     `json:"payment_number"`
+		InvoiceNumber string     `json:"invoice_number"`
+		Date          zoho.Date  `json:"date"`
+		PaymentMode   string     `json:"payment_mode"`
+		Amount        zoho.Money `json:"amount"`
+		BcyAmount     zoho.Money `json:"bcy_amount"`
 	} `json:"customerpayments"`
 }
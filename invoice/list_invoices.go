@@ -49,31 +49,31 @@ type ListInvoicesResponse struct {
 	Code     int    `json:"code"`
 	Message  string `json:"message"`
 	Invoices []struct {
-		InvoiceID            string  `json:"invoice_id"`
-		AchPaymentInitiated  bool    `json:"ach_payment_initiated"`
-		CustomerName         string  `json:"customer_name"`
-		CustomerID           string  `json:"customer_id"`
-		Status               string  `json:"status"`
-		InvoiceNumber        string  `json:"invoice_number"`
-		ReferenceNumber      string  `json:"reference_number"`
-		Date                 string  `json:"date"`
-		DueDate              string  `json:"due_date"`
-		DueDays              string  `json:"due_days"`
-		CurrencyID           string  `json:"currency_id"`
-		ScheduleTime         string  `json:"schedule_time"`
-		CurrencyCode         string  `json:"currency_code"`
-		IsViewedByClient     bool    `json:"is_viewed_by_client"`
-		HasAttachment        bool    `json:"has_attachment"`
-		ClientViewedTime     string  `json:"client_viewed_time"`
-		Total                float64 `json:"total"`
-		Balance              float64 `json:"balance"`
-		CreatedTime          string  `json:"created_time"`
-		LastModifiedTime     string  `json:"last_modified_time"`
-		IsEmailed            bool    `json:"is_emailed"`
-		RemindersSent        int64   `json:"reminders_sent"`
-		LastReminderSentDate string  `json:"last_reminder_sent_date"`
-		PaymentExpectedDate  string  `json:"payment_expected_date"`
-		LastPaymentDate      string  `json:"last_payment_date"`
+		InvoiceID            string     `json:"invoice_id"`
+		AchPaymentInitiated  bool       `json:"ach_payment_initiated"`
+		CustomerName         string     `json:"customer_name"`
+		CustomerID           string     `json:"customer_id"`
+		Status               string     `json:"status"`
+		InvoiceNumber        string     `json:"invoice_number"`
+		ReferenceNumber      string     `json:"reference_number"`
+		Date                 zoho.Date  `json:"date"`
+		DueDate              zoho.Date  `json:"due_date"`
+		DueDays              string     `json:"due_days"`
+		CurrencyID           string     `json:"currency_id"`
+		ScheduleTime         string     `json:"schedule_time"`
+		CurrencyCode         string     `json:"currency_code"`
+		IsViewedByClient     bool       `json:"is_viewed_by_client"`
+		HasAttachment        bool       `json:"has_attachment"`
+		ClientViewedTime     zoho.Time  `json:"client_viewed_time"`
+		Total                zoho.Money `json:"total"`
+		Balance              zoho.Money `json:"balance"`
+		CreatedTime          zoho.Time  `json:"created_time"`
+		LastModifiedTime     zoho.Time  `json:"last_modified_time"`
+		IsEmailed            bool       `json:"is_emailed"`
+		RemindersSent        int64      `json:"reminders_sent"`
+		LastReminderSentDate zoho.Date  `json:"last_reminder_sent_date"`
+		PaymentExpectedDate  zoho.Date  `json:"payment_expected_date"`
+		LastPaymentDate      zoho.Date  `json:"last_payment_date"`
 		/*CustomFields  []struct {
 			CustomfieldID string `json:"customfield_id"`
 			Label         string `json:"label"`
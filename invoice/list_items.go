@@ -46,17 +46,17 @@ type ListItemsResponse struct {
 	Code    int    `json:"code"`
 	Message string `json:"message"`
 	Items   []struct {
-		ItemID        string  `json:"item_id"`
-		Name          string  `json:"name"`
-		Status        string  `json:"status"`
-		Description   string  `json:"description"`
-		Rate          float64 `json:"rate"`
-		Unit          string  `json:"unit"`
-		TaxID         string  `json:"tax_id"`
-		TaxName       string  `json:"tax_name"`
-		TaxPercentage float64 `json:"tax_percentage"`
-		TaxType       string  `json:"tax_type"`
-		SKU           string  `json:"sku"`
-		ProductType   string  `json:"product_type"`
+		ItemID        string     `json:"item_id"`
+		Name          string     `json:"name"`
+		Status        string     `json:"status"`
+		Description   string     `json:"description"`
+		Rate          zoho.Money `json:"rate"`
+		Unit          string     `json:"unit"`
+		TaxID         string     `json:"tax_id"`
+		TaxName       string     `json:"tax_name"`
+		TaxPercentage float64    `json:"tax_percentage"`
+		TaxType       string     `json:"tax_type"`
+		SKU           string     `json:"sku"`
+		ProductType   string     `json:"product_type"`
 	} `json:"items"`
 }
@@ -57,18 +57,18 @@ type ListRecurringInvoicesResponse struct {
 	Code              int    `json:"code"`
 	Message           string `json:"message"`
 	RecurringInvoices []struct {
-		RecurringInvoiceId  string  `json:"recurring_invoice_id"`
-		RecurrenceName      string  `json:"recurrence_name"`
-		ReferenceNumber     string  `json:"reference_number"`
-		Status              string  `json:"status"`
-		Total               float64 `json:"total"`
-		CustomerId          string  `json:"customer_id"`
-		CustomerName        string  `json:"customer_name"`
-		StartDate           string  `json:"start_date"`
-		EndDate             string  `json:"end_date"`
-		LastSentDate        string  `json:"last_sent_date"`
-		NextInvoiceDate     string  `json:"next_invoice_date"`
-		RecurrenceFrequency string  `json:"recurrence_frequency"`
-		RepeatEvery         int64   `json:"repeat_every"`
+		RecurringInvoiceId  string     `json:"recurring_invoice_id"`
+		RecurrenceName      string     `json:"recurrence_name"`
+		ReferenceNumber     string     `json:"reference_number"`
+		Status              string     `json:"status"`
+		Total               zoho.Money `json:"total"`
+		CustomerId          string     `json:"customer_id"`
+		CustomerName        string     `json:"customer_name"`
+		StartDate           zoho.Date  `json:"start_date"`
+		EndDate             zoho.Date  `json:"end_date"`
+		LastSentDate        zoho.Date  `json:"last_sent_date"`
+		NextInvoiceDate     zoho.Date  `json:"next_invoice_date"`
+		RecurrenceFrequency string     `json:"recurrence_frequency"`
+		RepeatEvery         int64      `json:"repeat_every"`
 	} `json:"recurring_invoices"`
 }
@@ -53,26 +53,26 @@ type RetrievePaymentResponse struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
 	Payment struct {
-		PaymentId           string  `json:"payment_id"`
-		PaymentMode         string  `json:"payment_mode"`
-		Amount              float64 `json:"amount"`
-		AmountRefunded      float64 `json:"amount_refunded"`
-		BankCharges         float64 `json:"bank_charges"`
-		Date                string  `json:"date"`
-		Status              string  `json:"status"`
-		ReferenceNumber     string  `json:"reference_number"`
-		OnlineTransactionId string  `json:"online_transaction_id"`
-		CustomerId          string  `json:"customer_id"`
-		CustomerName        string  `json:"customer_name"`
-		Email               string  `json:"email"`
+		PaymentId           string     `json:"payment_id"`
+		PaymentMode         string     `json:"payment_mode"`
+		Amount              zoho.Money `json:"amount"`
+		AmountRefunded      zoho.Money `json:"amount_refunded"`
+		BankCharges         zoho.Money `json:"bank_charges"`
+		Date                zoho.Date  `json:"date"`
+		Status              string     `json:"status"`
+		ReferenceNumber     string     `json:"reference_number"`
+		OnlineTransactionId string     `json:"online_transaction_id"`
+		CustomerId          string     `json:"customer_id"`
+		CustomerName        string     `json:"customer_name"`
+		Email               string     `json:"email"`
 		Invoices            []struct {
-			InvoiceId        string  `json:"invoice_id"`
-			InvoicePaymentId string  `json:"invoice_payment_id"`
-			InvoiceNumber    string  `json:"invoice_number"`
-			Date             string  `json:"date"`
-			InvoiceAmount    float64 `json:"invoice_amount"`
-			AmountApplied    float64 `json:"amount_applied"`
-			BalanceAmount    float64 `json:"balance_amount"`
+			InvoiceId        string     `json:"invoice_id"`
+			InvoicePaymentId string     `json:"invoice_payment_id"`
+			InvoiceNumber    string     `json:"invoice_number"`
+			Date             zoho.Date  `json:"date"`
+			InvoiceAmount    zoho.Money `json:"invoice_amount"`
+			AmountApplied    zoho.Money `json:"amount_applied"`
+			BalanceAmount    zoho.Money `json:"balance_amount"`
 		} `json:"invoices"`
 		CurrencyCode   string `json:"currency_code"`
 		CurrencySymbol string `json:"currency_symbol"`
@@ -65,8 +65,8 @@ type UpdateRecurringInvoiceRequest struct {
 	SalespersonId       string               `json:"salesperson_id,omitempty"`
 	IsInclusiveTax      bool                 `json:"is_inclusive_tax,omitempty"`
 	ContactPersons      []string             `json:"contact_persons,omitempty"`
-	StartDate           string               `json:"start_date,omitempty"`
-	EndDate             string               `json:"end_date,omitempty"`
+	StartDate           *zoho.Date           `json:"start_date,omitempty"`
+	EndDate             *zoho.Date           `json:"end_date,omitempty"`
 	PlaceOfSupply       string               `json:"place_of_supply,omitempty"`
 	GstTreatment        string               `json:"gst_treatment,omitempty"`
 	GstNo               string               `json:"gst_no,omitempty"`
@@ -87,43 +87,34 @@ type UpdateRecurringInvoiceResponse struct {
 	Code             int64  `json:"code"`
 	Message          string `json:"message"`
 	RecurringInvoice struct {
-		RecurringInvoiceId string `json:"recurring_invoice_id"`
-		RecurrenceName     string `json:"recurrence_name"`
-		ReferenceNumber    string `json:"reference_number"`
-		IsPreGst           bool   `json:"is_pre_gst"`
-		GstNo              string `json:"gst_no"`
-		GstTreatment       string `json:"gst_treatment"`
-		PlaceOfSupply      string `json:"place_of_supply"`
-		CustomerName       string `json:"customer_name"`
-		CustomerId         string `json:"customer_id"`
-		CurrencyId         string `json:"currency_id"`
-		CurrencyCode       string `json:"currency_code"`
-		StartDate          string `json:"start_date"`
-		EndDate            string `json:"end_date"`
-		LastSentDate       string `json:"last_sent_date"`
-		NextInvoiceDate    string `json:"next_invoice_date"`
+		RecurringInvoiceId string    `json:"recurring_invoice_id"`
+		RecurrenceName     string    `json:"recurrence_name"`
+		ReferenceNumber    string    `json:"reference_number"`
+		IsPreGst           bool      `json:"is_pre_gst"`
+		GstNo              string    `json:"gst_no"`
+		GstTreatment       string    `json:"gst_treatment"`
+		PlaceOfSupply      string    `json:"place_of_supply"`
+		CustomerName       string    `json:"customer_name"`
+		CustomerId         string    `json:"customer_id"`
+		CurrencyId         string    `json:"currency_id"`
+		CurrencyCode       string    `json:"currency_code"`
+		StartDate          zoho.Date `json:"start_date"`
+		EndDate            zoho.Date `json:"end_date"`
+		LastSentDate       zoho.Date `json:"last_sent_date"`
+		NextInvoiceDate    zoho.Date `json:"next_invoice_date"`
 		LineItems          []struct {
-			LineItemId  string  `json:"line_item_id"`
-			Quantity    int64   `json:"quantity"`
-			Name        string  `json:"name"`
-			ItemTotal   float64 `json:"item_total"`
-			Sku         string  `json:"sku"`
-			ProductType string  `json:"product_type"`
-			ProjectId   string  `json:"project_id"`
-			ProjectName string  `json:"project_name"`
+			LineItemId  string     `json:"line_item_id"`
+			Quantity    int64      `json:"quantity"`
+			Name        string     `json:"name"`
+			ItemTotal   zoho.Money `json:"item_total"`
+			Sku         string     `json:"sku"`
+			ProductType string     `json:"product_type"`
+			ProjectId   string     `json:"project_id"`
+			ProjectName string     `json:"project_name"`
 		} `json:"line_items"`
 		BillingAddress  ContactAddress `json:"billing_address"`
 		ShippingAddress ContactAddress `json:"shipping_address"`
-		CustomFields    []struct {
-			CustomfieldId string `json:"customfield_id"`
-			DataType      string `json:"data_type"`
-			Index         int64  `json:"index"`
-			IsActive      bool   `json:"is_active"`
-			Label         string `json:"label"`
-			ShowInAllPdf  bool   `json:"show_in_all_pdf"`
-			ShowOnPdf     bool   `json:"show_on_pdf"`
-			Value         string `json:"value"`
-		} `json:"custom_fields"`
-		PaymentOptions PaymentOptions `json:"payment_options"`
+		CustomFields    []CustomField  `json:"custom_fields"`
+		PaymentOptions  PaymentOptions `json:"payment_options"`
 	}
 }
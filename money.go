@@ -0,0 +1,300 @@
+package zoho
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money is an exact decimal amount in a currency. Zoho returns amounts either as JSON numbers
+// or as strings, both are decoded without loss and encoded back in the form they were received.
+// The zero value is an amount of 0 without a currency.
+//
+// Zoho sends the currency of a document in a separate field such as currency_code, so decoded
+// amounts have no currency and RoundToCurrency rounds them to 2 places. Set the currency with
+// WithCurrency, for example amount.WithCurrency(invoice.CurrencyCode), before rounding.
+type Money struct {
+	unscaled *big.Int // nil is treated as 0
+	scale    int32    // number of digits after the decimal point
+	quoted   bool     // whether the amount is encoded as a JSON string
+	currency string
+}
+
+// currencyPrecision lists the currencies whose minor unit is not 1/100 of the major unit
+var currencyPrecision = map[string]int32{
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+}
+
+// CurrencyPrecision returns the number of decimal places amounts in the currency are rounded to
+func CurrencyPrecision(currency string) int32 {
+	if p, ok := currencyPrecision[strings.ToUpper(currency)]; ok {
+		return p
+	}
+	return 2
+}
+
+// NewMoney returns the amount unscaled / 10^scale in the currency, NewMoney(1999, 2, "USD") is 19.99 USD
+func NewMoney(unscaled int64, scale int32, currency string) Money {
+	m := Money{unscaled: big.NewInt(unscaled), scale: scale, currency: currency}
+	if scale < 0 {
+		m.unscaled.Mul(m.unscaled, pow10(-scale))
+		m.scale = 0
+	}
+	return m
+}
+
+// NewMoneyFromFloat returns the amount in the currency using the shortest decimal
+// representation of f, amounts already stored as float64 may have drifted
+func NewMoneyFromFloat(f float64, currency string) Money {
+	m, _ := ParseMoney(strconv.FormatFloat(f, 'f', -1, 64), currency)
+	return m
+}
+
+// maxMoneyExponent is the largest exponent accepted by ParseMoney, amounts never need more and
+// larger ones would allocate huge numbers
+const maxMoneyExponent = 64
+
+// ParseMoney parses a decimal amount such as "-1234.50" or "1.5e3" in the currency
+func ParseMoney(s, currency string) (Money, error) {
+	m := Money{currency: currency}
+	text := strings.TrimSpace(s)
+
+	var exponent int64
+	if i := strings.IndexAny(text, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(text[i+1:], 10, 32)
+		if err != nil || e > maxMoneyExponent || e < -maxMoneyExponent {
+			return Money{}, fmt.Errorf("Failed to parse money %q: invalid exponent", s)
+		}
+		exponent = e
+		text = text[:i]
+	}
+
+	negative := strings.HasPrefix(text, "-")
+	if negative || strings.HasPrefix(text, "+") {
+		text = text[1:]
+	}
+	integer, fraction := text, ""
+	if i := strings.Index(text, "."); i >= 0 {
+		integer, fraction = text[:i], text[i+1:]
+	}
+
+	digits := integer + fraction
+	if digits == "" || strings.Trim(digits, "0123456789") != "" || len(text) > len(digits)+1 {
+		return Money{}, fmt.Errorf("Failed to parse money %q: not a decimal number", s)
+	}
+
+	m.unscaled, _ = new(big.Int).SetString(digits, 10)
+	if negative {
+		m.unscaled.Neg(m.unscaled)
+	}
+
+	scale := int64(len(fraction)) - exponent
+	if scale < 0 {
+		m.unscaled.Mul(m.unscaled, pow10(int32(-scale)))
+		scale = 0
+	}
+	m.scale = int32(scale)
+	return m, nil
+}
+
+// Sum returns the total of the amounts, in the currency of the first amount
+func Sum(amounts ...Money) Money {
+	var total Money
+	for _, a := range amounts {
+		total = total.Add(a)
+	}
+	return total
+}
+
+// Currency returns the currency code of the amount
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// WithCurrency returns the amount in the currency
+func (m Money) WithCurrency(currency string) Money {
+	m.currency = currency
+	return m
+}
+
+// Precision returns the number of decimal places of the currency of the amount
+func (m Money) Precision() int32 {
+	return CurrencyPrecision(m.currency)
+}
+
+// Scale returns the number of digits after the decimal point of the amount
+func (m Money) Scale() int32 {
+	return m.scale
+}
+
+// Add returns m + o, the result is in the currency of m, or of o when m has none
+func (m Money) Add(o Money) Money {
+	x, y, scale := align(m, o)
+	return m.result(new(big.Int).Add(x, y), scale, o)
+}
+
+// Sub returns m - o, the result is in the currency of m, or of o when m has none
+func (m Money) Sub(o Money) Money {
+	x, y, scale := align(m, o)
+	return m.result(new(big.Int).Sub(x, y), scale, o)
+}
+
+// Mul returns m * o exactly, for example a rate multiplied by a quantity
+func (m Money) Mul(o Money) Money {
+	return m.result(new(big.Int).Mul(m.int(), o.int()), m.scale+o.scale, o)
+}
+
+// MulInt returns m * n
+func (m Money) MulInt(n int64) Money {
+	return m.result(new(big.Int).Mul(m.int(), big.NewInt(n)), m.scale, m)
+}
+
+// Neg returns -m
+func (m Money) Neg() Money {
+	return m.result(new(big.Int).Neg(m.int()), m.scale, m)
+}
+
+// Abs returns the absolute value of m
+func (m Money) Abs() Money {
+	return m.result(new(big.Int).Abs(m.int()), m.scale, m)
+}
+
+// Round returns m rounded half away from zero to the number of decimal places
+func (m Money) Round(places int32) Money {
+	if places < 0 {
+		places = 0
+	}
+	if m.scale <= places {
+		return m.result(new(big.Int).Mul(m.int(), pow10(places-m.scale)), places, m)
+	}
+
+	q, r := new(big.Int).QuoRem(m.int(), pow10(m.scale-places), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(r), big.NewInt(2)).Cmp(pow10(m.scale-places)) >= 0 {
+		q.Add(q, big.NewInt(int64(m.int().Sign())))
+	}
+	return m.result(q, places, m)
+}
+
+// RoundToCurrency returns m rounded to the precision of its currency, 2 places when it has none
+func (m Money) RoundToCurrency() Money {
+	return m.Round(m.Precision())
+}
+
+// Cmp compares the values of m and o, returning -1, 0 or +1, the currencies are not compared
+func (m Money) Cmp(o Money) int {
+	x, y, _ := align(m, o)
+	return x.Cmp(y)
+}
+
+// Equal reports whether m and o are the same amount in the same currency, regardless of scale
+func (m Money) Equal(o Money) bool {
+	return m.Cmp(o) == 0 && strings.EqualFold(m.currency, o.currency)
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of m
+func (m Money) Sign() int {
+	return m.int().Sign()
+}
+
+// IsZero reports whether the amount is 0
+func (m Money) IsZero() bool {
+	return m.Sign() == 0
+}
+
+// Float64 returns the nearest float64 to the amount, for display or interoperability only
+func (m Money) Float64() float64 {
+	f, _ := strconv.ParseFloat(m.String(), 64)
+	return f
+}
+
+// String returns the amount as a decimal number without the currency, keeping its scale
+func (m Money) String() string {
+	digits := new(big.Int).Abs(m.int()).String()
+	if m.scale > 0 {
+		if pad := int(m.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(m.scale)] + "." + digits[len(digits)-int(m.scale):]
+	}
+	if m.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// MarshalJSON is the json marshalling function for Money internal type
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.quoted {
+		if m.unscaled == nil {
+			return []byte(`""`), nil
+		}
+		return json.Marshal(m.String())
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON is the json unmarshalling function for Money internal type, the currency of m is
+// kept since the JSON amount carries none
+func (m *Money) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		*m = Money{currency: m.currency}
+		return nil
+	}
+
+	quoted := strings.HasPrefix(s, "\"")
+	if quoted {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		if strings.TrimSpace(s) == "" {
+			*m = Money{currency: m.currency, quoted: true}
+			return nil
+		}
+	}
+
+	parsed, err := ParseMoney(s, m.currency)
+	if err != nil {
+		return err
+	}
+	parsed.quoted = quoted
+	*m = parsed
+	return nil
+}
+
+// int returns the unscaled value of m
+func (m Money) int() *big.Int {
+	if m.unscaled == nil {
+		return new(big.Int)
+	}
+	return m.unscaled
+}
+
+// result returns a Money with the value, keeping the encoding of m and its currency or that of o
+func (m Money) result(unscaled *big.Int, scale int32, o Money) Money {
+	currency := m.currency
+	if currency == "" {
+		currency = o.currency
+	}
+	return Money{unscaled: unscaled, scale: scale, quoted: m.quoted, currency: currency}
+}
+
+// align returns the unscaled values of a and b expressed with the same scale
+func align(a, b Money) (x, y *big.Int, scale int32) {
+	x, y, scale = a.int(), b.int(), a.scale
+	switch {
+	case a.scale < b.scale:
+		x, scale = new(big.Int).Mul(x, pow10(b.scale-a.scale)), b.scale
+	case a.scale > b.scale:
+		y = new(big.Int).Mul(y, pow10(a.scale-b.scale))
+	}
+	return x, y, scale
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,174 @@
+package zoho
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "0", want: "0"},
+		{in: "19.99", want: "19.99"},
+		{in: "-1234.50", want: "-1234.50"},
+		{in: "+5", want: "5"},
+		{in: " 7.25 ", want: "7.25"},
+		{in: ".5", want: "0.5"},
+		{in: "5.", want: "5"},
+		{in: "1.5e3", want: "1500"},
+		{in: "1.5E-2", want: "0.015"},
+		{in: "12e-2", want: "0.12"},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "1,000", wantErr: true},
+		{in: "--5", wantErr: true},
+		{in: "+-5", wantErr: true},
+		{in: "-+5", wantErr: true},
+		{in: "5-", wantErr: true},
+		{in: "1e", wantErr: true},
+		{in: "1e65", wantErr: true},
+		{in: "1e-65", wantErr: true},
+		{in: "1e999999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.in, "USD")
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseMoney(%q) = %s, want error", tt.in, m)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseMoney(%q) returned error: %s", tt.in, err)
+			continue
+		}
+		if got := m.String(); got != tt.want {
+			t.Errorf("ParseMoney(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+		if m.Currency() != "USD" {
+			t.Errorf("ParseMoney(%q) currency = %q, want USD", tt.in, m.Currency())
+		}
+	}
+}
+
+func TestMoneyJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: `12.30`, want: `12.30`},
+		{in: `"12.30"`, want: `"12.30"`},
+		{in: `0.1`, want: `0.1`},
+		{in: `-0.005`, want: `-0.005`},
+		{in: `""`, want: `""`},
+		{in: `null`, want: `0`},
+		{in: `1e2`, want: `100`},
+	}
+
+	for _, tt := range tests {
+		var m Money
+		if err := json.Unmarshal([]byte(tt.in), &m); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %s", tt.in, err)
+			continue
+		}
+		b, err := json.Marshal(m)
+		if err != nil {
+			t.Errorf("Marshal(%s) returned error: %s", tt.in, err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("round trip of %s = %s, want %s", tt.in, b, tt.want)
+		}
+	}
+
+	var m Money
+	if err := json.Unmarshal([]byte(`"abc"`), &m); err == nil {
+		t.Errorf("Unmarshal(\"abc\") = %s, want error", m)
+	}
+
+	// The currency set before decoding is kept
+	m = Money{}.WithCurrency("JPY")
+	if err := json.Unmarshal([]byte(`1234.5`), &m); err != nil {
+		t.Fatalf("Unmarshal returned error: %s", err)
+	}
+	if m.Currency() != "JPY" || m.RoundToCurrency().String() != "1235" {
+		t.Errorf("decoded %s %s, want 1235 JPY", m.RoundToCurrency(), m.Currency())
+	}
+}
+
+func TestMoneyRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int32
+		want   string
+	}{
+		{in: "1.005", places: 2, want: "1.01"},
+		{in: "1.004", places: 2, want: "1.00"},
+		{in: "-1.005", places: 2, want: "-1.01"},
+		{in: "-1.004", places: 2, want: "-1.00"},
+		{in: "2.5", places: 0, want: "3"},
+		{in: "-2.5", places: 0, want: "-3"},
+		{in: "0.049", places: 1, want: "0.0"},
+		{in: "7", places: 2, want: "7.00"},
+		{in: "1.23", places: -1, want: "1"},
+	}
+
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.in, "")
+		if err != nil {
+			t.Fatalf("ParseMoney(%q) returned error: %s", tt.in, err)
+		}
+		if got := m.Round(tt.places).String(); got != tt.want {
+			t.Errorf("Round(%s, %d) = %s, want %s", tt.in, tt.places, got, tt.want)
+		}
+	}
+
+	byCurrency := map[string]string{"": "10.13", "USD": "10.13", "KWD": "10.126", "JPY": "10"}
+	for currency, want := range byCurrency {
+		m, _ := ParseMoney("10.1255", currency)
+		if got := m.RoundToCurrency().String(); got != want {
+			t.Errorf("RoundToCurrency(10.1255 %s) = %s, want %s", currency, got, want)
+		}
+	}
+}
+
+func TestMoneyAdd(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want string
+	}{
+		{a: "0.1", b: "0.2", want: "0.3"},
+		{a: "1.50", b: "2.5", want: "4.00"},
+		{a: "10", b: "-0.01", want: "9.99"},
+		{a: "-1.1", b: "1.1", want: "0.0"},
+		{a: "0", b: "0.005", want: "0.005"},
+	}
+
+	for _, tt := range tests {
+		a, _ := ParseMoney(tt.a, "EUR")
+		b, _ := ParseMoney(tt.b, "")
+		got := a.Add(b)
+		if got.String() != tt.want {
+			t.Errorf("%s + %s = %s, want %s", tt.a, tt.b, got, tt.want)
+		}
+		if got.Currency() != "EUR" {
+			t.Errorf("%s + %s currency = %q, want EUR", tt.a, tt.b, got.Currency())
+		}
+	}
+
+	// The zero value takes the currency of the amount added to it
+	if got := (Money{}).Add(NewMoney(5, 0, "GBP")); got.Currency() != "GBP" || got.String() != "5" {
+		t.Errorf("Money{} + 5 GBP = %s %s, want 5 GBP", got, got.Currency())
+	}
+
+	sum := Sum(NewMoney(10, 1, "USD"), NewMoney(20, 1, "USD"), NewMoney(-3, 2, "USD"))
+	if sum.String() != "2.97" {
+		t.Errorf("Sum = %s, want 2.97", sum)
+	}
+}
@@ -0,0 +1,76 @@
+package zoho
+
+import "encoding/json"
+
+// Nullable is a field value which distinguishes being unset, explicitly null and holding a value.
+// It is used through a pointer with 'omitempty': a nil *Nullable is unset and omitted from
+// requests, a null one is sent as null which clears the field in Zoho, and a valid one is sent as
+// its Value.
+//
+//	type Lead struct {
+//	    ID    string         `json:"id"`
+//	    Phone *zoho.Nullable `json:"Phone,omitempty"`
+//	}
+//	Lead{ID: id}                                 // Phone is left untouched
+//	Lead{ID: id, Phone: zoho.NullValue()}        // Phone is cleared
+//	Lead{ID: id, Phone: zoho.NewNullable("555")} // Phone is set to "555"
+type Nullable struct {
+	// Value is the value of the field when Valid, values unmarshalled from JSON are held as
+	// json.RawMessage, use Decode to retrieve them as a specific type
+	Value interface{}
+	// Valid is false when the field is null
+	Valid bool
+}
+
+// NullValue returns a Nullable which clears the field
+func NullValue() *Nullable {
+	return &Nullable{}
+}
+
+// NewNullable returns a Nullable holding the value v
+func NewNullable(v interface{}) *Nullable {
+	return &Nullable{Value: v, Valid: true}
+}
+
+// IsNull reports whether n is unset or explicitly null
+func (n *Nullable) IsNull() bool {
+	return n == nil || !n.Valid
+}
+
+// Decode stores the value held by n in the value pointed to by v, leaving it untouched when
+// n is unset or null
+func (n *Nullable) Decode(v interface{}) error {
+	if n.IsNull() {
+		return nil
+	}
+
+	raw, ok := n.Value.(json.RawMessage)
+	if !ok {
+		var err error
+		if raw, err = json.Marshal(n.Value); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// MarshalJSON is the json marshalling function for Nullable internal type
+func (n Nullable) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON is the json unmarshalling function for Nullable internal type
+func (n *Nullable) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*n = Nullable{}
+		return nil
+	}
+
+	raw := make(json.RawMessage, len(b))
+	copy(raw, b)
+	*n = Nullable{Value: raw, Valid: true}
+	return nil
+}
@@ -0,0 +1,78 @@
+package zoho
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNullableMarshalJSON(t *testing.T) {
+	type record struct {
+		ID    string    `json:"id"`
+		Phone *Nullable `json:"Phone,omitempty"`
+	}
+	tests := []struct {
+		name string
+		in   record
+		want string
+	}{
+		{name: "omitted", in: record{ID: "1"}, want: `{"id":"1"}`},
+		{name: "null", in: record{ID: "1", Phone: NullValue()}, want: `{"id":"1","Phone":null}`},
+		{
+			name: "set",
+			in:   record{ID: "1", Phone: NewNullable("555")},
+			want: `{"id":"1","Phone":"555"}`,
+		},
+		{
+			name: "set empty",
+			in:   record{ID: "1", Phone: NewNullable("")},
+			want: `{"id":"1","Phone":""}`,
+		},
+		{
+			name: "zero value",
+			in:   record{ID: "1", Phone: &Nullable{}},
+			want: `{"id":"1","Phone":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Errorf("%s: Marshal returned error: %s", tt.name, err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("%s: Marshal = %s, want %s", tt.name, b, tt.want)
+		}
+	}
+}
+
+func TestNullableUnmarshalJSON(t *testing.T) {
+	var n Nullable
+	if err := json.Unmarshal([]byte(`{"a":1}`), &n); err != nil {
+		t.Fatalf("Unmarshal returned error: %s", err)
+	}
+	if n.IsNull() {
+		t.Fatalf("IsNull() = true, want false")
+	}
+	var v struct{ A int }
+	if err := n.Decode(&v); err != nil || v.A != 1 {
+		t.Errorf("Decode = %+v, %v, want {A:1}", v, err)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &n); err != nil {
+		t.Fatalf("Unmarshal returned error: %s", err)
+	}
+	if !n.IsNull() {
+		t.Errorf("IsNull() = false after null, want true")
+	}
+
+	var unset *Nullable
+	if !unset.IsNull() || unset.Decode(&v) != nil {
+		t.Errorf("nil Nullable is not null")
+	}
+
+	s := ""
+	if err := NewNullable("555").Decode(&s); err != nil || s != "555" {
+		t.Errorf("Decode = %q, %v, want 555", s, err)
+	}
+}
@@ -385,6 +385,10 @@ const (
 	SettingsScope Scope = "settings"
 	// ModulesScope is a possible Scope portion of the scope string
 	ModulesScope Scope = "modules"
+	// BulkScope is a possible Scope portion of the scope string
+	BulkScope Scope = "bulk"
+	// NotificationsScope is a possible Scope portion of the scope string
+	NotificationsScope Scope = "notifications"
 
 	// Additional Scopes related to expense APIs
 
@@ -2,7 +2,6 @@ package recruit
 
 import (
 	"fmt"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -49,7 +48,7 @@ func (c *API) InsertCandidates(
 
 type CandidateRequestData struct {
 	CandidateId       string `json:"CandidateId,omitempty"`
-	ApplicationDate   string `json:"ApplicationDate,omitempty"`
+	ApplicationDate   *Date  `json:"ApplicationDate,omitempty"`
 	FirstName         string `json:"First_Name,omitempty"`
 	LastName          string `json:"Last_Name,omitempty"`
 	City              string `json:"City,omitempty"`
@@ -74,13 +73,13 @@ type InsertCandidateResponse struct {
 	Data []struct {
 		Code    string `json:"code"`
 		Details struct {
-			ModifiedTime time.Time `json:"Modified_Time"`
+			ModifiedTime Time `json:"Modified_Time"`
 			ModifiedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
 			} `json:"Modified_By"`
-			CreatedTime time.Time `json:"Created_Time"`
-			ID          string    `json:"id"`
+			CreatedTime Time   `json:"Created_Time"`
+			ID          string `json:"id"`
 			CreatedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
@@ -131,13 +130,13 @@ type UpsertCandidateResponse struct {
 		DuplicateField string `json:"duplicate_field"`
 		Action         string `json:"action"`
 		Details        struct {
-			ModifiedTime time.Time `json:"Modified_Time"`
+			ModifiedTime Time `json:"Modified_Time"`
 			ModifiedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
 			} `json:"Modified_By"`
-			CreatedTime time.Time `json:"Created_Time"`
-			ID          string    `json:"id"`
+			CreatedTime Time   `json:"Created_Time"`
+			ID          string `json:"id"`
 			CreatedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
@@ -243,7 +242,7 @@ type CandidatesResponse struct {
 		CandidateStatus string `json:"Candidate_Status,omitempty"`
 		CandidateID     string `json:"Candidate_ID,omitempty"`
 		LastMailedTime  Time   `json:"Last_Mailed_Time,omitempty"`
-		CreatedTime     string `json:"Created_Time,omitempty"`
+		CreatedTime     *Time  `json:"Created_Time,omitempty"`
 		Followed        string `json:"followed,omitempty"`
 		CandidateOwner  struct {
 			Name string `json:"name,omitempty"`
@@ -348,13 +347,13 @@ type CandidateRelatedRecordsResponse struct {
 			Name string `json:"name"`
 			ID   string `json:"id"`
 		} `json:"$attach_type"`
-		ModifiedTime    time.Time `json:"Modified_Time"`
-		FileName        string    `json:"File_Name"`
-		Size            string    `json:"Size"`
-		CreatedTime     time.Time `json:"Created_Time"`
-		LinkDocs        int       `json:"$link_docs"`
-		ParentID        string    `json:"Parent_Id"`
-		Editable        bool      `json:"$editable"`
+		ModifiedTime    Time   `json:"Modified_Time"`
+		FileName        string `json:"File_Name"`
+		Size            string `json:"Size"`
+		CreatedTime     Time   `json:"Created_Time"`
+		LinkDocs        int    `json:"$link_docs"`
+		ParentID        string `json:"Parent_Id"`
+		Editable        bool   `json:"$editable"`
 		AttachmentOwner struct {
 			Name string `json:"name"`
 			ID   string `json:"id"`
@@ -116,7 +116,7 @@ type ClientsRecordsResponse struct {
 		IsStatusSplitDone bool       `json:"isStatusSplitDone,omitempty"`
 		ModifiedTime      Time       `json:"Modified_Time,omitempty"`
 		BillingStreet     string     `json:"Billing_Street,omitempty"`
-		LastMailedTime    string     `json:"Last_Mailed_Time,omitempty"`
+		LastMailedTime    *Time      `json:"Last_Mailed_Time,omitempty"`
 		CreatedTime       Time       `json:"Created_Time,omitempty"`
 		Followed          bool       `json:"$followed,omitempty"`
 		Editable          bool       `json:"$editable,omitempty"`
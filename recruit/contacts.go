@@ -128,7 +128,7 @@ type ContactsRecordsResponse struct {
 		IsStatusSplitDone      bool       `json:"isStatusSplitDone,omitempty"`
 		ModifiedTime           Time       `json:"Modified_Time,omitempty"`
 		MailingCity            string     `json:"Mailing_City,omitempty"`
-		LastMailedTime         string     `json:"Last_Mailed_Time,omitempty"`
+		LastMailedTime         *Time      `json:"Last_Mailed_Time,omitempty"`
 		OtherCity              string     `json:"Other_City,omitempty"`
 		CreatedTime            Time       `json:"Created_Time,omitempty"`
 		ClientPortalUserStatus string     `json:"Client_Portal_User_Status,omitempty"`
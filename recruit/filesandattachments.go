@@ -2,7 +2,6 @@ package recruit
 
 import (
 	"fmt"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -53,13 +52,13 @@ type UploadAttachmentResponse struct {
 	Data []struct {
 		Code    string `json:"code"`
 		Details struct {
-			ModifiedTime time.Time `json:"Modified_Time"`
+			ModifiedTime Time `json:"Modified_Time"`
 			ModifiedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
 			} `json:"Modified_By"`
-			CreatedTime time.Time `json:"Created_Time"`
-			ID          string    `json:"id"`
+			CreatedTime Time   `json:"Created_Time"`
+			ID          string `json:"id"`
 			CreatedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
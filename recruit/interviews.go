@@ -2,7 +2,6 @@ package recruit
 
 import (
 	"fmt"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -96,9 +95,9 @@ type InterviewsRecordsResponse struct {
 			Name string `json:"name,omitempty"`
 			ID   string `json:"id,omitempty"`
 		} `json:"Client_Name,omitempty"`
-		CurrencySymbol           string    `json:"$currency_symbol,omitempty"`
-		VideoInterviewIsReviewed bool      `json:"$video_interview_isreviewed,omitempty"`
-		StartDateTime            time.Time `json:"Start_DateTime,omitempty"`
+		CurrencySymbol           string `json:"$currency_symbol,omitempty"`
+		VideoInterviewIsReviewed bool   `json:"$video_interview_isreviewed,omitempty"`
+		StartDateTime            Time   `json:"Start_DateTime,omitempty"`
 		InterviewDuration        struct {
 			Mins int `json:"mins,omitempty"`
 			Hrs  int `json:"hrs,omitempty"`
@@ -149,7 +148,7 @@ type InterviewsRecordsResponse struct {
 			Name string `json:"name,omitempty"`
 			ID   string `json:"id,omitempty"`
 		} `json:"Job_Opening_Name,omitempty"`
-		ReviewedTime string `json:"Reviewed_Time,omitempty"`
+		ReviewedTime *Time `json:"Reviewed_Time,omitempty"`
 		Interviewer  []struct {
 			Name     string `json:"name,omitempty"`
 			ID       string `json:"id,omitempty"`
@@ -4,7 +4,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"strconv"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -133,39 +132,39 @@ type JobOpening struct {
 		Name string `json:"name,omitempty"`
 		ID   string `json:"id,omitempty"`
 	} `json:"Account_Manager,omitempty"`
-	NoOfCandidatesHired int       `json:"No_of_Candidates_Hired,omitempty"`
-	TargetDate          string    `json:"Target_Date,omitempty"`
-	LastActivityTime    time.Time `json:"Last_Activity_Time,omitempty"`
-	Industry            string    `json:"Industry,omitempty"`
+	NoOfCandidatesHired int    `json:"No_of_Candidates_Hired,omitempty"`
+	TargetDate          *Date  `json:"Target_Date,omitempty"`
+	LastActivityTime    Time   `json:"Last_Activity_Time,omitempty"`
+	Industry            string `json:"Industry,omitempty"`
 	ModifiedBy          struct {
 		Name string `json:"name,omitempty"`
 		ID   string `json:"id,omitempty"`
 	} `json:"Modified_By,omitempty"`
-	ProcessFlow     bool   `json:"$process_flow,omitempty"`
-	ExpectedRevenue int    `json:"Expected_Revenue,omitempty"`
-	IsHotJobOpening bool   `json:"Is_Hot_Job_Opening,omitempty"`
-	ZipCode         string `json:"Zip_Code,omitempty"`
-	ID              string `json:"id,omitempty"`
-	Approved        bool   `json:"$approved,omitempty"`
-	Publish         bool   `json:"Publish,omitempty"`
-	DateOpened      string `json:"Date_Opened,omitempty"`
+	ProcessFlow     bool      `json:"$process_flow,omitempty"`
+	ExpectedRevenue *Currency `json:"Expected_Revenue,omitempty"`
+	IsHotJobOpening bool      `json:"Is_Hot_Job_Opening,omitempty"`
+	ZipCode         string    `json:"Zip_Code,omitempty"`
+	ID              string    `json:"id,omitempty"`
+	Approved        bool      `json:"$approved,omitempty"`
+	Publish         bool      `json:"Publish,omitempty"`
+	DateOpened      string    `json:"Date_Opened,omitempty"`
 	Approval        struct {
 		Delegate bool `json:"delegate,omitempty"`
 		Approve  bool `json:"approve,omitempty"`
 		Reject   bool `json:"reject,omitempty"`
 		Resubmit bool `json:"resubmit,omitempty"`
 	} `json:"$approval,omitempty"`
-	ModifiedTime             time.Time   `json:"Modified_Time,omitempty"`
-	ActualRevenue            interface{} `json:"Actual_Revenue,omitempty"`
-	RemoteJob                bool        `json:"Remote_Job,omitempty"`
-	CreatedTime              time.Time   `json:"Created_Time,omitempty"`
-	Followed                 bool        `json:"$followed,omitempty"`
-	NoOfCandidatesAssociated int         `json:"No_of_Candidates_Associated,omitempty"`
-	Editable                 bool        `json:"$editable,omitempty"`
-	IsLocked                 bool        `json:"Is_Locked,omitempty"`
-	City                     string      `json:"City,omitempty"`
-	JobOpeningStatus         string      `json:"Job_Opening_Status,omitempty"`
-	RevenuePerPosition       int         `json:"Revenue_per_Position,omitempty"`
+	ModifiedTime             Time      `json:"Modified_Time,omitempty"`
+	ActualRevenue            *Currency `json:"Actual_Revenue,omitempty"`
+	RemoteJob                bool      `json:"Remote_Job,omitempty"`
+	CreatedTime              Time      `json:"Created_Time,omitempty"`
+	Followed                 bool      `json:"$followed,omitempty"`
+	NoOfCandidatesAssociated int       `json:"No_of_Candidates_Associated,omitempty"`
+	Editable                 bool      `json:"$editable,omitempty"`
+	IsLocked                 bool      `json:"Is_Locked,omitempty"`
+	City                     string    `json:"City,omitempty"`
+	JobOpeningStatus         string    `json:"Job_Opening_Status,omitempty"`
+	RevenuePerPosition       *Currency `json:"Revenue_per_Position,omitempty"`
 	ContactName              struct {
 		Name string `json:"name,omitempty"`
 		ID   string `json:"id,omitempty"`
@@ -180,15 +179,15 @@ type JobOpening struct {
 		Email    string `json:"email,omitempty"`
 		PhotoSrc string `json:"photoSrc,omitempty"`
 	} `json:"Assigned_Recruiter,omitempty"`
-	MissedRevenue     interface{} `json:"Missed_Revenue,omitempty"`
-	JobOpeningID      string      `json:"Job_Opening_ID,omitempty"`
-	JobDescription    string      `json:"Job_Description,omitempty"`
-	WorkExperience    string      `json:"Work_Experience,omitempty"`
-	JobType           string      `json:"Job_Type,omitempty"`
-	JobOpeningName    string      `json:"Job_Opening_Name,omitempty"`
-	NumberOfPositions string      `json:"Number_of_Positions,omitempty"`
-	State             string      `json:"State,omitempty"`
-	Country           string      `json:"Country,omitempty"`
+	MissedRevenue     *Currency `json:"Missed_Revenue,omitempty"`
+	JobOpeningID      string    `json:"Job_Opening_ID,omitempty"`
+	JobDescription    string    `json:"Job_Description,omitempty"`
+	WorkExperience    string    `json:"Work_Experience,omitempty"`
+	JobType           string    `json:"Job_Type,omitempty"`
+	JobOpeningName    string    `json:"Job_Opening_Name,omitempty"`
+	NumberOfPositions string    `json:"Number_of_Positions,omitempty"`
+	State             string    `json:"State,omitempty"`
+	Country           string    `json:"Country,omitempty"`
 	CreatedBy         struct {
 		Name string `json:"name,omitempty"`
 		ID   string `json:"id,omitempty"`
@@ -238,12 +237,12 @@ type AssociatedCandidatesResponse struct {
 		Origin                   string      `json:"Origin"`
 		Email                    string      `json:"Email"`
 		CurrencySymbol           string      `json:"$currency_symbol"`
-		LastActivityTime         time.Time   `json:"Last_Activity_Time"`
+		LastActivityTime         Time        `json:"Last_Activity_Time"`
 		HighestQualificationHeld interface{} `json:"Highest_Qualification_Held"`
 		SkillSet                 interface{} `json:"Skill_Set"`
 		Converted                bool        `json:"$converted"`
 		ProcessFlow              bool        `json:"$process_flow"`
-		UpdatedOn                time.Time   `json:"Updated_On"`
+		UpdatedOn                Time        `json:"Updated_On"`
 		CurrentEmployer          string      `json:"Current_Employer"`
 		Street                   interface{} `json:"Street"`
 		ZipCode                  interface{} `json:"Zip_Code"`
@@ -259,7 +258,7 @@ type AssociatedCandidatesResponse struct {
 		CandidateStatus string      `json:"Candidate_Status"`
 		CandidateID     string      `json:"Candidate_ID"`
 		LastMailedTime  interface{} `json:"Last_Mailed_Time"`
-		CreatedTime     time.Time   `json:"Created_Time"`
+		CreatedTime     Time        `json:"Created_Time"`
 		Followed        bool        `json:"$followed"`
 		CandidateOwner  struct {
 			Name string `json:"name"`
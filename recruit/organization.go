@@ -2,7 +2,6 @@ package recruit
 
 import (
 	"fmt"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -45,11 +44,11 @@ type OrganizationResponse struct {
 		McStatus       bool        `json:"mc_status"`
 		CountryCode    string      `json:"country_code"`
 		LicenseDetails struct {
-			TrialType   string    `json:"trial_type"`
-			TrialExpiry time.Time `json:"trial_expiry"`
-			Paid        bool      `json:"paid"`
-			PaidType    string    `json:"paid_type"`
-			NoOfUsers   int       `json:"no_of_users"`
+			TrialType   string `json:"trial_type"`
+			TrialExpiry Time   `json:"trial_expiry"`
+			Paid        bool   `json:"paid"`
+			PaidType    string `json:"paid_type"`
+			NoOfUsers   int    `json:"no_of_users"`
 		} `json:"license_details"`
 		Zgid          string      `json:"zgid"`
 		Phone         string      `json:"phone"`
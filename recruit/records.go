@@ -2,12 +2,21 @@ package recruit
 
 import (
 	"fmt"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
 
+// SearchRecords is used for searching records in the specified module using the parameters.
+// Parameters are 'criteria', 'email', 'phone', and 'word', the 'criteria' is best built with zoho.Criteria
 // https://www.zoho.com/recruit/developer-guide/apiv2/search-records.html
+//
+//	criteria, err := zoho.Or(zoho.Equals("Email", "a@b.com"), zoho.Equals("Mobile", "555")).Parameter()
+//	if err != nil {
+//	    return err
+//	}
+//	recruit.SearchRecords(&data, recruit.CandidatesModule, map[string]zoho.Parameter{
+//	    "criteria": criteria,
+//	})
 func (c *API) SearchRecords(
 	request interface{},
 	module Module,
@@ -105,8 +114,8 @@ type InsertRecordsResponseData struct {
 			ID   string `json:"id,omitempty"`
 			Name string `json:"name,omitempty"`
 		} `json:"modified_by,omitempty"`
-		ModifiedTime time.Time `json:"modified_time,omitempty"`
-		CreatedTime  time.Time `json:"created_time,omitempty"`
+		ModifiedTime Time `json:"modified_time,omitempty"`
+		CreatedTime  Time `json:"created_time,omitempty"`
 	} `json:"details,omitempty"`
 	Status string `json:"status,omitempty"`
 	Code   string `json:"code,omitempty"`
@@ -119,14 +128,18 @@ type InsertRecordsResponse struct {
 // UpsertRecords will insert the provided records in the request, if they already exist it will be updated
 // https://www.zoho.com/recruit/developer-guide/apiv2/upsert-records.html
 //
-// When performing an upsert, because the natural state of the records fields in this package is to 'omitempty' when encoding json,
-// if you want to empty the fields contents in zoho you will need to embed the records type in a struct in your own package,
-// and override the field with a field that has a json tag that does not contain 'omitempty'.
+// Fields of the records types in this package are 'omitempty', so empty values are not sent to zoho.
+// Records can be provided as any struct or map, the fields to clear are provided as a *Nullable
+// set to zoho.NullValue(), and nil *Nullable fields are left untouched.
 // eg.
-//    type struct Candidate {
-//        zohorecruit.Candidate
-//        CustomField string `json:"Custom_Field"`
-//     }
+//
+//	type Candidate struct {
+//	    ID          string            `json:"id"`
+//	    Phone       *recruit.Nullable `json:"Phone,omitempty"`
+//	    CustomField *recruit.Nullable `json:"Custom_Field,omitempty"`
+//	}
+//
+//	Candidate{ID: id, Phone: zoho.NewNullable("555"), CustomField: zoho.NullValue()}
 func (c *API) UpsertRecords(
 	request UpsertRecords,
 	module Module,
@@ -212,10 +225,10 @@ type AssociateRecordsResponse struct {
 			Name string `json:"name"`
 			ID   string `json:"id"`
 		} `json:"Account_Manager"`
-		NoOfCandidatesHired int       `json:"No_of_Candidates_Hired"`
-		TargetDate          string    `json:"Target_Date"`
-		LastActivityTime    time.Time `json:"Last_Activity_Time"`
-		Industry            string    `json:"Industry"`
+		NoOfCandidatesHired int    `json:"No_of_Candidates_Hired"`
+		TargetDate          Date   `json:"Target_Date"`
+		LastActivityTime    Time   `json:"Last_Activity_Time"`
+		Industry            string `json:"Industry"`
 		ModifiedBy          struct {
 			Name string `json:"name"`
 			ID   string `json:"id"`
@@ -240,9 +253,9 @@ type AssociateRecordsResponse struct {
 			Reject   bool `json:"reject"`
 			Resubmit bool `json:"resubmit"`
 		} `json:"$approval"`
-		ModifiedTime             time.Time     `json:"Modified_Time"`
+		ModifiedTime             Time          `json:"Modified_Time"`
 		ActualRevenue            interface{}   `json:"Actual_Revenue"`
-		CreatedTime              time.Time     `json:"Created_Time"`
+		CreatedTime              Time          `json:"Created_Time"`
 		Followed                 bool          `json:"$followed"`
 		NoOfCandidatesAssociated int           `json:"No_of_Candidates_Associated"`
 		Editable                 bool          `json:"$editable"`
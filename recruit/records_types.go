@@ -1,23 +1,21 @@
 package recruit
 
-import "time"
-
 type InsertCandidatesData struct {
-	Origin                   string    `json:"Origin"`
-	Email                    string    `json:"Email"`
-	CurrencySymbol           string    `json:"$currency_symbol"`
-	LastActivityTime         time.Time `json:"Last_Activity_Time"`
-	HighestQualificationHeld string    `json:"Highest_Qualification_Held"`
-	SkillSet                 string    `json:"Skill_Set"`
-	Converted                bool      `json:"$converted"`
-	ProcessFlow              bool      `json:"$process_flow"`
-	UpdatedOn                time.Time `json:"Updated_On"`
-	CurrentEmployer          string    `json:"Current_Employer"`
-	Street                   string    `json:"Street"`
-	ZipCode                  string    `json:"Zip_Code"`
-	ID                       string    `json:"id"`
-	ExperienceInYears        int       `json:"Experience_in_Years"`
-	Approved                 bool      `json:"$approved"`
+	Origin                   string `json:"Origin"`
+	Email                    string `json:"Email"`
+	CurrencySymbol           string `json:"$currency_symbol"`
+	LastActivityTime         Time   `json:"Last_Activity_Time"`
+	HighestQualificationHeld string `json:"Highest_Qualification_Held"`
+	SkillSet                 string `json:"Skill_Set"`
+	Converted                bool   `json:"$converted"`
+	ProcessFlow              bool   `json:"$process_flow"`
+	UpdatedOn                Time   `json:"Updated_On"`
+	CurrentEmployer          string `json:"Current_Employer"`
+	Street                   string `json:"Street"`
+	ZipCode                  string `json:"Zip_Code"`
+	ID                       string `json:"id"`
+	ExperienceInYears        int    `json:"Experience_in_Years"`
+	Approved                 bool   `json:"$approved"`
 	Approval                 struct {
 		Delegate bool `json:"delegate"`
 		Approve  bool `json:"approve"`
@@ -27,7 +25,7 @@ type InsertCandidatesData struct {
 	CandidateStatus string      `json:"Candidate_Status"`
 	CandidateID     string      `json:"Candidate_ID"`
 	LastMailedTime  interface{} `json:"Last_Mailed_Time"`
-	CreatedTime     time.Time   `json:"Created_Time"`
+	CreatedTime     Time        `json:"Created_Time"`
 	Followed        bool        `json:"$followed"`
 	CandidateOwner  struct {
 		Name string `json:"name"`
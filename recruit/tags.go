@@ -2,7 +2,6 @@ package recruit
 
 import (
 	"fmt"
-	"time"
 
 	zoho "github.com/schmorrison/Zoho"
 )
@@ -64,8 +63,8 @@ type CreateTagsResponse struct {
 	Tags []struct {
 		Code    string `json:"code"`
 		Details struct {
-			CreatedTime  time.Time `json:"created_time"`
-			ModifiedTime time.Time `json:"modified_time"`
+			CreatedTime  Time `json:"created_time"`
+			ModifiedTime Time `json:"modified_time"`
 			ModifiedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
@@ -265,8 +264,8 @@ func (c *API) GetTagsList(
 type TagsListResponse struct {
 	Data struct {
 		Tags []struct {
-			CreatedTime  time.Time `json:"created_time"`
-			ModifiedTime time.Time `json:"modified_time"`
+			CreatedTime  Time `json:"created_time"`
+			ModifiedTime Time `json:"modified_time"`
 			ModifiedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
@@ -318,8 +317,8 @@ type UpdateTagResponse struct {
 	Tags []struct {
 		Code    string `json:"code"`
 		Details struct {
-			CreatedTime  time.Time `json:"created_time"`
-			ModifiedTime time.Time `json:"modified_time"`
+			CreatedTime  Time `json:"created_time"`
+			ModifiedTime Time `json:"modified_time"`
 			ModifiedBy   struct {
 				Name string `json:"name"`
 				ID   string `json:"id"`
@@ -23,8 +23,9 @@ type PageInfo struct {
 type MultiSelect []string
 type Date = zoho.Date
 type Time = zoho.Time
+type Nullable = zoho.Nullable
 type Number int
-type Currency float64
+type Currency = zoho.Money
 type Decimal float64
 type Percent float64
 type Long int64
@@ -49,8 +50,7 @@ type SingleLine string
 
 func (s *SingleLine) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := SingleLine("")
-		*s = t
+		*s = ""
 		return nil
 	}
 
@@ -59,16 +59,12 @@ func (s *SingleLine) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := SingleLine(t)
-	*s = tp
+	*s = SingleLine(t)
 	return nil
 }
 
 func (s SingleLine) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // MultiLine is the field type in Zoho that defines a multiline input field, like text area in HTML
@@ -76,8 +72,7 @@ type MultiLine string
 
 func (s *MultiLine) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := MultiLine("")
-		*s = t
+		*s = ""
 		return nil
 	}
 
@@ -86,16 +81,12 @@ func (s *MultiLine) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := MultiLine(t)
-	*s = tp
+	*s = MultiLine(t)
 	return nil
 }
 
 func (s MultiLine) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // Email is the field type in Zoho that defines an email address field
@@ -103,8 +94,7 @@ type Email string
 
 func (s *Email) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := Email("")
-		*s = t
+		*s = ""
 		return nil
 	}
 
@@ -113,16 +103,12 @@ func (s *Email) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := Email(t)
-	*s = tp
+	*s = Email(t)
 	return nil
 }
 
 func (s Email) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // Phone is the field type in Zoho that defines a phone number field
@@ -130,8 +116,7 @@ type Phone string
 
 func (s *Phone) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := Phone("")
-		*s = t
+		*s = ""
 		return nil
 	}
 
@@ -140,16 +125,12 @@ func (s *Phone) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := Phone(t)
-	*s = tp
+	*s = Phone(t)
 	return nil
 }
 
 func (s Phone) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
 
 // PickList is the field type in Zoho that defines a dropdown that has been selected
@@ -157,8 +138,7 @@ type PickList string
 
 func (s *PickList) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
-		t := PickList("")
-		*s = t
+		*s = ""
 		return nil
 	}
 
@@ -167,14 +147,10 @@ func (s *PickList) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	tp := PickList(t)
-	*s = tp
+	*s = PickList(t)
 	return nil
 }
 
 func (s PickList) MarshalJSON() ([]byte, error) {
-	if string(s) == "" {
-		return []byte{}, nil
-	}
-	return []byte(s), nil
+	return json.Marshal(string(s))
 }
@@ -3,7 +3,6 @@ package shifts
 import (
 	"fmt"
 	"math/rand"
-	"strings"
 	"time"
 
 	zoho "github.com/schmorrison/Zoho"
@@ -57,6 +56,8 @@ const (
 	dateLayout = "2006-01-02"
 )
 
+// Time is a time.Time sent to Zoho Shifts in UTC, the zero Time is sent as an empty string.
+// Every format accepted by zoho.Time is accepted when unmarshalling.
 type Time time.Time
 
 func (t Time) MarshalJSON() (b []byte, err error) {
@@ -68,13 +69,8 @@ func (t Time) MarshalJSON() (b []byte, err error) {
 }
 
 func (t *Time) UnmarshalJSON(b []byte) (err error) {
-	s := strings.Trim(string(b), `"`)
-	if s == "null" || s == "\"null\"" || s == "" {
-		return nil
-	}
-
-	tm, err := time.Parse(timeLayout, s)
-	if err != nil {
+	var tm zoho.Time
+	if err := tm.UnmarshalJSON(b); err != nil {
 		return fmt.Errorf("failed to parse shifts.Time from JSON: %s", err)
 	}
 	*t = Time(tm)
@@ -90,6 +86,8 @@ func (t Time) IsZero() bool {
 	return time.Time(t).IsZero()
 }
 
+// Date is a time.Time sent to Zoho Shifts as a date, the zero Date is sent as an empty string.
+// Every format accepted by zoho.Date is accepted when unmarshalling.
 type Date time.Time
 
 func (d Date) MarshalJSON() (b []byte, err error) {
@@ -101,10 +99,9 @@ func (d Date) MarshalJSON() (b []byte, err error) {
 }
 
 func (d *Date) UnmarshalJSON(b []byte) (err error) {
-	s := strings.Trim(string(b), `"`)
-	dm, err := time.Parse(dateLayout, s)
-	if err != nil {
-		return fmt.Errorf("failed to parse shifts.Time from JSON: %s", err)
+	var dm zoho.Date
+	if err := dm.UnmarshalJSON(b); err != nil {
+		return fmt.Errorf("failed to parse shifts.Date from JSON: %s", err)
 	}
 	*d = Date(dm)
 	return nil
@@ -0,0 +1,49 @@
+package shifts
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimeJSON(t *testing.T) {
+	ist := time.FixedZone("", 5*3600+1800)
+	tests := []struct {
+		in   interface{}
+		want string
+	}{
+		{in: Time(time.Date(2019, 5, 9, 15, 19, 13, 0, ist)), want: `"2019-05-09T09:49:13Z"`},
+		{in: Time{}, want: `""`},
+		{in: Date(time.Date(2019, 5, 9, 0, 0, 0, 0, time.UTC)), want: `"2019-05-09"`},
+		{in: Date{}, want: `""`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Errorf("Marshal(%v) returned error: %s", tt.in, err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("Marshal(%v) = %s, want %s", tt.in, b, tt.want)
+		}
+	}
+
+	for _, in := range []string{`"2019-05-09T09:49:13Z"`, `"2019-05-09T15:19:13+05:30"`} {
+		var tm Time
+		if err := json.Unmarshal([]byte(in), &tm); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %s", in, err)
+			continue
+		}
+		if b, _ := json.Marshal(tm); string(b) != `"2019-05-09T09:49:13Z"` {
+			t.Errorf("round trip of %s = %s, want \"2019-05-09T09:49:13Z\"", in, b)
+		}
+	}
+
+	for _, in := range []string{`null`, `""`} {
+		var d Date
+		if err := json.Unmarshal([]byte(in), &d); err != nil || !d.IsZero() {
+			t.Errorf("Unmarshal(%s) = %v, %v, want zero Date", in, d, err)
+		}
+	}
+}
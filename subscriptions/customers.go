@@ -88,15 +88,15 @@ type CustomerResponse struct {
 		GstTreatment           string        `json:"gst_treatment"`
 		PlaceOfContact         string        `json:"place_of_contact"`
 		PricePrecision         int64         `json:"price_precision"`
-		UnusedCredits          float64       `json:"unused_credits"`
-		Outstanding            float64       `json:"outstanding"`
+		UnusedCredits          zoho.Money    `json:"unused_credits"`
+		Outstanding            zoho.Money    `json:"outstanding"`
 		Notes                  string        `json:"notes"`
 		Status                 string        `json:"status"`
 		CustomFields           []CustomField `json:"custom_fields"`
 		ZcrmAccountID          string        `json:"zcrm_account_id"`
 		ZcrmContactID          string        `json:"zcrm_contact_id"`
-		UpdatedTime            string        `json:"updated_time"`
-		CreatedTime            string        `json:"created_time"`
+		UpdatedTime            zoho.Time     `json:"updated_time"`
+		CreatedTime            zoho.Time     `json:"created_time"`
 		Source                 string        `json:"source"`
 		PaymentTermsLabel      string        `json:"payment_terms_label"`
 		IsLinkedWithZohocrm    bool          `json:"is_linked_with_zohocrm"`
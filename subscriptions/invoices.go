@@ -309,20 +309,20 @@ type CollectChargeViaBankAccountResponse struct {
 	Message string                       `json:"message"`
 	Invoice CollectChargeInvoiceResponse `json:"invoice,omitempty"`
 	Payment struct {
-		PaymentID       string  `json:"payment_id"`
-		PaymentMode     string  `json:"payment_mode"`
-		Amount          float64 `json:"amount"`
-		AmountRefunded  float64 `json:"amount_refunded"`
-		BankCharges     float64 `json:"bank_charges"`
-		Date            string  `json:"date"`
-		Status          string  `json:"status"`
-		ReferenceNumber string  `json:"reference_number"`
-		DueDate         string  `json:"due_date"`
-		AmountDue       float64 `json:"amount_due"`
-		Description     string  `json:"description"`
-		CustomerID      string  `json:"customer_id"`
-		CustomerName    string  `json:"customer_name"`
-		Email           string  `json:"email"`
+		PaymentID       string     `json:"payment_id"`
+		PaymentMode     string     `json:"payment_mode"`
+		Amount          zoho.Money `json:"amount"`
+		AmountRefunded  zoho.Money `json:"amount_refunded"`
+		BankCharges     zoho.Money `json:"bank_charges"`
+		Date            zoho.Date  `json:"date"`
+		Status          string     `json:"status"`
+		ReferenceNumber string     `json:"reference_number"`
+		DueDate         zoho.Date  `json:"due_date"`
+		AmountDue       zoho.Money `json:"amount_due"`
+		Description     string     `json:"description"`
+		CustomerID      string     `json:"customer_id"`
+		CustomerName    string     `json:"customer_name"`
+		Email           string     `json:"email"`
 		Autotransaction struct {
 			AutotransactionID    string `json:"autotransaction_id"`
 			PaymentGateway       string `json:"payment_gateway"`
@@ -331,18 +331,18 @@ type CollectChargeViaBankAccountResponse struct {
 			AccountID            string `json:"account_id"`
 		} `json:"autotransaction"`
 		Invoices []struct {
-			InvoiceID     string  `json:"invoice_id"`
-			InvoiceNumber string  `json:"invoice_number"`
-			Date          string  `json:"date"`
-			InvoiceAmount float64 `json:"invoice_amount"`
-			AmountApplied float64 `json:"amount_applied"`
-			BalanceAmount float64 `json:"balance_amount"`
+			InvoiceID     string     `json:"invoice_id"`
+			InvoiceNumber string     `json:"invoice_number"`
+			Date          zoho.Date  `json:"date"`
+			InvoiceAmount zoho.Money `json:"invoice_amount"`
+			AmountApplied zoho.Money `json:"amount_applied"`
+			BalanceAmount zoho.Money `json:"balance_amount"`
 		} `json:"invoices"`
 		CurrencyCode   string        `json:"currency_code"`
 		CurrencySymbol string        `json:"currency_symbol"`
 		CustomFields   []CustomField `json:"custom_fields"`
-		CreatedTime    string        `json:"created_time"`
-		UpdatedTime    string        `json:"updated_time"`
+		CreatedTime    zoho.Time     `json:"created_time"`
+		UpdatedTime    zoho.Time     `json:"updated_time"`
 	} `json:"payment,omitempty"`
 }
 
@@ -355,18 +355,18 @@ type CollectChargeViaCreditCardResponse struct {
 	Message string                       `json:"message"`
 	Invoice CollectChargeInvoiceResponse `json:"invoice,omitempty"`
 	Payment struct {
-		PaymentID       string  `json:"payment_id"`
-		PaymentMode     string  `json:"payment_mode"`
-		Amount          float64 `json:"amount"`
-		AmountRefunded  float64 `json:"amount_refunded"`
-		BankCharges     float64 `json:"bank_charges"`
-		Date            string  `json:"date"`
-		Status          string  `json:"status"`
-		ReferenceNumber string  `json:"reference_number"`
-		Description     string  `json:"description"`
-		CustomerID      string  `json:"customer_id"`
-		CustomerName    string  `json:"customer_name"`
-		Email           string  `json:"email"`
+		PaymentID       string     `json:"payment_id"`
+		PaymentMode     string     `json:"payment_mode"`
+		Amount          zoho.Money `json:"amount"`
+		AmountRefunded  zoho.Money `json:"amount_refunded"`
+		BankCharges     zoho.Money `json:"bank_charges"`
+		Date            zoho.Date  `json:"date"`
+		Status          string     `json:"status"`
+		ReferenceNumber string     `json:"reference_number"`
+		Description     string     `json:"description"`
+		CustomerID      string     `json:"customer_id"`
+		CustomerName    string     `json:"customer_name"`
+		Email           string     `json:"email"`
 		Autotransaction struct {
 			AutotransactionID    string `json:"autotransaction_id"`
 			PaymentGateway       string `json:"payment_gateway"`
@@ -378,35 +378,35 @@ type CollectChargeViaCreditCardResponse struct {
 			ExpiryYear           int64  `json:"expiry_year"`
 		} `json:"autotransaction"`
 		Invoices []struct {
-			InvoiceID     string  `json:"invoice_id"`
-			InvoiceNumber string  `json:"invoice_number"`
-			Date          string  `json:"date"`
-			InvoiceAmount float64 `json:"invoice_amount"`
-			AmountApplied float64 `json:"amount_applied"`
-			BalanceAmount float64 `json:"balance_amount"`
+			InvoiceID     string     `json:"invoice_id"`
+			InvoiceNumber string     `json:"invoice_number"`
+			Date          zoho.Date  `json:"date"`
+			InvoiceAmount zoho.Money `json:"invoice_amount"`
+			AmountApplied zoho.Money `json:"amount_applied"`
+			BalanceAmount zoho.Money `json:"balance_amount"`
 		} `json:"invoices"`
 		CurrencyCode   string        `json:"currency_code"`
 		CurrencySymbol string        `json:"currency_symbol"`
 		CustomFields   []CustomField `json:"custom_fields"`
-		CreatedTime    string        `json:"created_time"`
-		UpdatedTime    string        `json:"updated_time"`
+		CreatedTime    zoho.Time     `json:"created_time"`
+		UpdatedTime    zoho.Time     `json:"updated_time"`
 	} `json:"payment,omitempty"`
 }
 
 type CollectChargeInvoiceResponse struct {
-	AchPaymentInitiated     bool    `json:"ach_payment_initiated"`
-	Adjustment              float64 `json:"adjustment"`
-	AdjustmentDescription   string  `json:"adjustment_description"`
-	AllowPartialPayments    bool    `json:"allow_partial_payments"`
-	ApproverID              string  `json:"approver_id"`
-	AutoRemindersConfigured bool    `json:"auto_reminders_configured"`
-	Balance                 float64 `json:"balance"`
-	BcyAdjustment           float64 `json:"bcy_adjustment"`
-	BcyDiscountTotal        float64 `json:"bcy_discount_total"`
-	BcyShippingCharge       float64 `json:"bcy_shipping_charge"`
-	BcySubTotal             float64 `json:"bcy_sub_total"`
-	BcyTaxTotal             float64 `json:"bcy_tax_total"`
-	BcyTotal                float64 `json:"bcy_total"`
+	AchPaymentInitiated     bool       `json:"ach_payment_initiated"`
+	Adjustment              zoho.Money `json:"adjustment"`
+	AdjustmentDescription   string     `json:"adjustment_description"`
+	AllowPartialPayments    bool       `json:"allow_partial_payments"`
+	ApproverID              string     `json:"approver_id"`
+	AutoRemindersConfigured bool       `json:"auto_reminders_configured"`
+	Balance                 zoho.Money `json:"balance"`
+	BcyAdjustment           zoho.Money `json:"bcy_adjustment"`
+	BcyDiscountTotal        zoho.Money `json:"bcy_discount_total"`
+	BcyShippingCharge       zoho.Money `json:"bcy_shipping_charge"`
+	BcySubTotal             zoho.Money `json:"bcy_sub_total"`
+	BcyTaxTotal             zoho.Money `json:"bcy_tax_total"`
+	BcyTotal                zoho.Money `json:"bcy_total"`
 	BillingAddress          struct {
 		Address   string `json:"address"`
 		Attention string `json:"attention"`
@@ -419,11 +419,11 @@ type CollectChargeInvoiceResponse struct {
 		Street2   string `json:"street2"`
 		Zip       string `json:"zip"`
 	} `json:"billing_address"`
-	CanEditItems       bool   `json:"can_edit_items"`
-	CanSendInMail      bool   `json:"can_send_in_mail"`
-	CanSendInvoiceSms  bool   `json:"can_send_invoice_sms"`
-	CanSkipPaymentInfo bool   `json:"can_skip_payment_info"`
-	ClientViewedTime   string `json:"client_viewed_time"`
+	CanEditItems       bool      `json:"can_edit_items"`
+	CanSendInMail      bool      `json:"can_send_in_mail"`
+	CanSendInvoiceSms  bool      `json:"can_send_invoice_sms"`
+	CanSkipPaymentInfo bool      `json:"can_skip_payment_info"`
+	ClientViewedTime   zoho.Time `json:"client_viewed_time"`
 	Contactpersons     []struct {
 		ContactpersonID string `json:"contactperson_id"`
 		Email           string `json:"email"`
@@ -431,15 +431,15 @@ type CollectChargeInvoiceResponse struct {
 		Phone           string `json:"phone"`
 		ZcrmContactID   string `json:"zcrm_contact_id"`
 	} `json:"contactpersons"`
-	Coupons         []Coupon `json:"coupons"`
-	CreatedByID     string   `json:"created_by_id"`
-	CreatedDate     string   `json:"created_date"`
-	CreatedTime     string   `json:"created_time"`
-	Credits         []Credit `json:"credits"`
-	CreditsApplied  float64  `json:"credits_applied"`
-	CurrencyCode    string   `json:"currency_code"`
-	CurrencyID      string   `json:"currency_id"`
-	CurrencySymbol  string   `json:"currency_symbol"`
+	Coupons         []Coupon   `json:"coupons"`
+	CreatedByID     string     `json:"created_by_id"`
+	CreatedDate     zoho.Date  `json:"created_date"`
+	CreatedTime     zoho.Time  `json:"created_time"`
+	Credits         []Credit   `json:"credits"`
+	CreditsApplied  zoho.Money `json:"credits_applied"`
+	CurrencyCode    string     `json:"currency_code"`
+	CurrencyID      string     `json:"currency_id"`
+	CurrencySymbol  string     `json:"currency_symbol"`
 	CustomFieldHash struct {
 	} `json:"custom_field_hash"`
 	CustomFields            []CustomField `json:"custom_fields"`
@@ -448,27 +448,27 @@ type CollectChargeInvoiceResponse struct {
 	CustomerCustomFields        []CustomField `json:"customer_custom_fields"`
 	CustomerID                  string        `json:"customer_id"`
 	CustomerName                string        `json:"customer_name"`
-	Date                        string        `json:"date"`
+	Date                        zoho.Date     `json:"date"`
 	DiscountPercent             float64       `json:"discount_percent"`
-	DiscountTotal               float64       `json:"discount_total"`
+	DiscountTotal               zoho.Money    `json:"discount_total"`
 	Documents                   []interface{} `json:"documents"`
-	DueDate                     string        `json:"due_date"`
+	DueDate                     zoho.Date     `json:"due_date"`
 	Email                       string        `json:"email"`
 	ExchangeRate                float64       `json:"exchange_rate"`
 	InprocessTransactionPresent bool          `json:"inprocess_transaction_present"`
-	InvoiceDate                 string        `json:"invoice_date"`
+	InvoiceDate                 zoho.Date     `json:"invoice_date"`
 	InvoiceID                   string        `json:"invoice_id"`
 	InvoiceItems                []struct {
 		AccountID        string        `json:"account_id"`
 		AccountName      string        `json:"account_name"`
 		Code             string        `json:"code"`
 		Description      string        `json:"description"`
-		DiscountAmount   float64       `json:"discount_amount"`
+		DiscountAmount   zoho.Money    `json:"discount_amount"`
 		ItemCustomFields []CustomField `json:"item_custom_fields"`
 		ItemID           string        `json:"item_id"`
-		ItemTotal        float64       `json:"item_total"`
+		ItemTotal        zoho.Money    `json:"item_total"`
 		Name             string        `json:"name"`
-		Price            float64       `json:"price"`
+		Price            zoho.Money    `json:"price"`
 		ProductID        string        `json:"product_id"`
 		ProductType      string        `json:"product_type"`
 		Quantity         int64         `json:"quantity"`
@@ -479,19 +479,19 @@ type CollectChargeInvoiceResponse struct {
 		TaxType          string        `json:"tax_type"`
 		Unit             string        `json:"unit"`
 	} `json:"invoice_items"`
-	InvoiceNumber          string `json:"invoice_number"`
-	InvoiceURL             string `json:"invoice_url"`
-	IsInclusiveTax         bool   `json:"is_inclusive_tax"`
-	IsReverseChargeApplied bool   `json:"is_reverse_charge_applied"`
-	IsViewedByClient       bool   `json:"is_viewed_by_client"`
-	IsViewedInMail         bool   `json:"is_viewed_in_mail"`
-	LastModifiedByID       string `json:"last_modified_by_id"`
-	MailFirstViewedTime    string `json:"mail_first_viewed_time"`
-	MailLastViewedTime     string `json:"mail_last_viewed_time"`
-	Notes                  string `json:"notes"`
-	Number                 string `json:"number"`
-	PageWidth              string `json:"page_width"`
-	PaymentExpectedDate    string `json:"payment_expected_date"`
+	InvoiceNumber          string    `json:"invoice_number"`
+	InvoiceURL             string    `json:"invoice_url"`
+	IsInclusiveTax         bool      `json:"is_inclusive_tax"`
+	IsReverseChargeApplied bool      `json:"is_reverse_charge_applied"`
+	IsViewedByClient       bool      `json:"is_viewed_by_client"`
+	IsViewedInMail         bool      `json:"is_viewed_in_mail"`
+	LastModifiedByID       string    `json:"last_modified_by_id"`
+	MailFirstViewedTime    zoho.Time `json:"mail_first_viewed_time"`
+	MailLastViewedTime     zoho.Time `json:"mail_last_viewed_time"`
+	Notes                  string    `json:"notes"`
+	Number                 string    `json:"number"`
+	PageWidth              string    `json:"page_width"`
+	PaymentExpectedDate    zoho.Date `json:"payment_expected_date"`
 	PaymentGateways        []struct {
 		PaymentGateway string `json:"payment_gateway"`
 	} `json:"payment_gateways"`
@@ -500,20 +500,20 @@ type CollectChargeInvoiceResponse struct {
 	PaymentTerms           int64  `json:"payment_terms"`
 	PaymentTermsLabel      string `json:"payment_terms_label"`
 	Payments               []struct {
-		Amount               float64 `json:"amount"`
-		AmountRefunded       float64 `json:"amount_refunded"`
-		BankCharges          float64 `json:"bank_charges"`
-		CardType             string  `json:"card_type"`
-		Date                 string  `json:"date"`
-		Description          string  `json:"description"`
-		ExchangeRate         float64 `json:"exchange_rate"`
-		GatewayTransactionID string  `json:"gateway_transaction_id"`
-		InvoicePaymentID     string  `json:"invoice_payment_id"`
-		LastFourDigits       string  `json:"last_four_digits"`
-		PaymentID            string  `json:"payment_id"`
-		PaymentMode          string  `json:"payment_mode"`
-		ReferenceNumber      string  `json:"reference_number"`
-		SettlementStatus     string  `json:"settlement_status"`
+		Amount               zoho.Money `json:"amount"`
+		AmountRefunded       zoho.Money `json:"amount_refunded"`
+		BankCharges          zoho.Money `json:"bank_charges"`
+		CardType             string     `json:"card_type"`
+		Date                 zoho.Date  `json:"date"`
+		Description          string     `json:"description"`
+		ExchangeRate         float64    `json:"exchange_rate"`
+		GatewayTransactionID string     `json:"gateway_transaction_id"`
+		InvoicePaymentID     string     `json:"invoice_payment_id"`
+		LastFourDigits       string     `json:"last_four_digits"`
+		PaymentID            string     `json:"payment_id"`
+		PaymentMode          string     `json:"payment_mode"`
+		ReferenceNumber      string     `json:"reference_number"`
+		SettlementStatus     string     `json:"settlement_status"`
 	} `json:"payments"`
 	PricePrecision  int64  `json:"price_precision"`
 	PricebookID     string `json:"pricebook_id"`
@@ -533,10 +533,10 @@ type CollectChargeInvoiceResponse struct {
 		Street2   string `json:"street2"`
 		Zip       string `json:"zip"`
 	} `json:"shipping_address"`
-	ShippingCharge                        float64       `json:"shipping_charge"`
-	ShippingChargeExclusiveOfTax          float64       `json:"shipping_charge_exclusive_of_tax"`
+	ShippingCharge                        zoho.Money    `json:"shipping_charge"`
+	ShippingChargeExclusiveOfTax          zoho.Money    `json:"shipping_charge_exclusive_of_tax"`
 	ShippingChargeExclusiveOfTaxFormatted string        `json:"shipping_charge_exclusive_of_tax_formatted"`
-	ShippingChargeInclusiveOfTax          float64       `json:"shipping_charge_inclusive_of_tax"`
+	ShippingChargeInclusiveOfTax          zoho.Money    `json:"shipping_charge_inclusive_of_tax"`
 	ShippingChargeInclusiveOfTaxFormatted string        `json:"shipping_charge_inclusive_of_tax_formatted"`
 	ShippingChargeTax                     string        `json:"shipping_charge_tax"`
 	ShippingChargeTaxFormatted            string        `json:"shipping_charge_tax_formatted"`
@@ -546,23 +546,23 @@ type CollectChargeInvoiceResponse struct {
 	ShippingChargeTaxType                 string        `json:"shipping_charge_tax_type"`
 	Status                                string        `json:"status"`
 	StopReminderUntilPaymentExpectedDate  bool          `json:"stop_reminder_until_payment_expected_date"`
-	SubTotal                              float64       `json:"sub_total"`
+	SubTotal                              zoho.Money    `json:"sub_total"`
 	SubmitterID                           string        `json:"submitter_id"`
 	Subscriptions                         []interface{} `json:"subscriptions"`
 	TaxRounding                           string        `json:"tax_rounding"`
-	TaxTotal                              float64       `json:"tax_total"`
+	TaxTotal                              zoho.Money    `json:"tax_total"`
 	Taxes                                 []interface{} `json:"taxes"`
 	TemplateID                            string        `json:"template_id"`
 	TemplateName                          string        `json:"template_name"`
 	TemplateType                          string        `json:"template_type"`
 	Terms                                 string        `json:"terms"`
-	Total                                 float64       `json:"total"`
+	Total                                 zoho.Money    `json:"total"`
 	TransactionType                       string        `json:"transaction_type"`
 	UnbilledChargesID                     string        `json:"unbilled_charges_id"`
-	UnusedCreditsReceivableAmount         float64       `json:"unused_credits_receivable_amount"`
-	UpdatedTime                           string        `json:"updated_time"`
+	UnusedCreditsReceivableAmount         zoho.Money    `json:"unused_credits_receivable_amount"`
+	UpdatedTime                           zoho.Time     `json:"updated_time"`
 	VatTreatment                          string        `json:"vat_treatment"`
-	WriteOffAmount                        float64       `json:"write_off_amount"`
+	WriteOffAmount                        zoho.Money    `json:"write_off_amount"`
 	ZcrmPotentialID                       string        `json:"zcrm_potential_id"`
 }
 
@@ -571,31 +571,31 @@ type AddItemsRequest struct {
 }
 
 type InvoiceItemRequest struct {
-	Code           string  `json:"code,omitempty"`
-	ProductID      string  `json:"product_id,omitempty"`
-	Name           string  `json:"name,omitempty"`
-	Description    string  `json:"description,omitempty"`
-	Price          float64 `json:"price,omitempty"`
-	Quantity       float64 `json:"quantity,omitempty"`
-	TaxID          string  `json:"tax_id,omitempty"`
-	TaxExemptionID string  `json:"tax_exemption_id,omitempty"`
+	Code           string      `json:"code,omitempty"`
+	ProductID      string      `json:"product_id,omitempty"`
+	Name           string      `json:"name,omitempty"`
+	Description    string      `json:"description,omitempty"`
+	Price          *zoho.Money `json:"price,omitempty"`
+	Quantity       float64     `json:"quantity,omitempty"`
+	TaxID          string      `json:"tax_id,omitempty"`
+	TaxExemptionID string      `json:"tax_exemption_id,omitempty"`
 }
 
 type AddItemsResponse struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
 	Invoice struct {
-		InvoiceID           string `json:"invoice_id"`
-		Number              string `json:"number"`
-		Status              string `json:"status"`
-		InvoiceDate         string `json:"invoice_date"`
-		DueDate             string `json:"due_date"`
-		PaymentExpectedDate string `json:"payment_expected_date"`
-		AchPaymentInitiated bool   `json:"ach_payment_initiated"`
-		TransactionType     string `json:"transaction_type"`
-		CustomerID          string `json:"customer_id"`
-		CustomerName        string `json:"customer_name"`
-		Email               string `json:"email"`
+		InvoiceID           string    `json:"invoice_id"`
+		Number              string    `json:"number"`
+		Status              string    `json:"status"`
+		InvoiceDate         zoho.Date `json:"invoice_date"`
+		DueDate             zoho.Date `json:"due_date"`
+		PaymentExpectedDate zoho.Date `json:"payment_expected_date"`
+		AchPaymentInitiated bool      `json:"ach_payment_initiated"`
+		TransactionType     string    `json:"transaction_type"`
+		CustomerID          string    `json:"customer_id"`
+		CustomerName        string    `json:"customer_name"`
+		Email               string    `json:"email"`
 		InvoiceItems        []struct {
 			ItemID           string        `json:"item_id"`
 			Name             string        `json:"name"`
@@ -603,10 +603,10 @@ type AddItemsResponse struct {
 			Tags             []Tag         `json:"tags"`
 			ItemCustomFields []CustomField `json:"item_custom_fields"`
 			Code             string        `json:"code"`
-			Price            float64       `json:"price"`
+			Price            zoho.Money    `json:"price"`
 			Quantity         float64       `json:"quantity"`
-			DiscountAmount   float64       `json:"discount_amount"`
-			ItemTotal        float64       `json:"item_total"`
+			DiscountAmount   zoho.Money    `json:"discount_amount"`
+			ItemTotal        zoho.Money    `json:"item_total"`
 			TaxID            string        `json:"tax_id"`
 			ProductType      string        `json:"product_type"`
 			HsnOrSac         string        `json:"hsn_or_sac"`
@@ -614,42 +614,42 @@ type AddItemsResponse struct {
 			TaxExemptionCode string        `json:"tax_exemption_code"`
 		} `json:"invoice_items"`
 		Coupons []struct {
-			CouponCode     string  `json:"coupon_code"`
-			CouponName     string  `json:"coupon_name"`
-			DiscountAmount float64 `json:"discount_amount"`
+			CouponCode     string     `json:"coupon_code"`
+			CouponName     string     `json:"coupon_name"`
+			DiscountAmount zoho.Money `json:"discount_amount"`
 		} `json:"coupons"`
 		Credits []struct {
-			CreditnoteID      string  `json:"creditnote_id"`
-			CreditnotesNumber string  `json:"creditnotes_number"`
-			CreditedDate      string  `json:"credited_date"`
-			CreditedAmount    float64 `json:"credited_amount"`
+			CreditnoteID      string     `json:"creditnote_id"`
+			CreditnotesNumber string     `json:"creditnotes_number"`
+			CreditedDate      zoho.Date  `json:"credited_date"`
+			CreditedAmount    zoho.Money `json:"credited_amount"`
 		} `json:"credits"`
-		Total          float64 `json:"total"`
-		PaymentMade    float64 `json:"payment_made"`
-		Balance        float64 `json:"balance"`
-		CreditsApplied float64 `json:"credits_applied"`
-		WriteOffAmount float64 `json:"write_off_amount"`
+		Total          zoho.Money `json:"total"`
+		PaymentMade    zoho.Money `json:"payment_made"`
+		Balance        zoho.Money `json:"balance"`
+		CreditsApplied zoho.Money `json:"credits_applied"`
+		WriteOffAmount zoho.Money `json:"write_off_amount"`
 		Payments       []struct {
-			PaymentID            string  `json:"payment_id"`
-			PaymentMode          string  `json:"payment_mode"`
-			InvoicePaymentID     string  `json:"invoice_payment_id"`
-			GatewayTransactionID string  `json:"gateway_transaction_id"`
-			Description          string  `json:"description"`
-			Date                 string  `json:"date"`
-			ReferenceNumber      string  `json:"reference_number"`
-			Amount               float64 `json:"amount"`
-			BankCharges          float64 `json:"bank_charges"`
-			ExchangeRate         float64 `json:"exchange_rate"`
+			PaymentID            string     `json:"payment_id"`
+			PaymentMode          string     `json:"payment_mode"`
+			InvoicePaymentID     string     `json:"invoice_payment_id"`
+			GatewayTransactionID string     `json:"gateway_transaction_id"`
+			Description          string     `json:"description"`
+			Date                 zoho.Date  `json:"date"`
+			ReferenceNumber      string     `json:"reference_number"`
+			Amount               zoho.Money `json:"amount"`
+			BankCharges          zoho.Money `json:"bank_charges"`
+			ExchangeRate         float64    `json:"exchange_rate"`
 		} `json:"payments"`
-		CurrencyCode    string  `json:"currency_code"`
-		CurrencySymbol  string  `json:"currency_symbol"`
-		CreatedTime     string  `json:"created_time"`
-		UpdatedTime     string  `json:"updated_time"`
-		SalespersonID   string  `json:"salesperson_id"`
-		SalespersonName string  `json:"salesperson_name"`
-		InvoiceURL      string  `json:"invoice_url"`
-		BillingAddress  Address `json:"billing_address"`
-		ShippingAddress Address `json:"shipping_address"`
+		CurrencyCode    string    `json:"currency_code"`
+		CurrencySymbol  string    `json:"currency_symbol"`
+		CreatedTime     zoho.Time `json:"created_time"`
+		UpdatedTime     zoho.Time `json:"updated_time"`
+		SalespersonID   string    `json:"salesperson_id"`
+		SalespersonName string    `json:"salesperson_name"`
+		InvoiceURL      string    `json:"invoice_url"`
+		BillingAddress  Address   `json:"billing_address"`
+		ShippingAddress Address   `json:"shipping_address"`
 		Comments        []struct {
 			CommentID       string `json:"comment_id"`
 			Description     string `json:"description"`
@@ -710,25 +710,25 @@ type Invoice struct {
 	InvoiceID            string        `json:"invoice_id,omitempty"`
 	Number               string        `json:"number,omitempty"`
 	Status               string        `json:"status,omitempty"`
-	InvoiceDate          string        `json:"invoice_date,omitempty"`
-	DueDate              string        `json:"due_date,omitempty"`
+	InvoiceDate          *zoho.Date    `json:"invoice_date,omitempty"`
+	DueDate              *zoho.Date    `json:"due_date,omitempty"`
 	CustomerID           string        `json:"customer_id,omitempty"`
 	CustomerName         string        `json:"customer_name,omitempty"`
 	Email                string        `json:"email,omitempty"`
-	Balance              float64       `json:"balance,omitempty"`
-	Total                float64       `json:"total,omitempty"`
-	PaymentMade          float64       `json:"payment_made,omitempty"`
-	CreditsApplied       float64       `json:"credits_applied,omitempty"`
-	WriteOffAmount       float64       `json:"write_off_amount,omitempty"`
+	Balance              *zoho.Money   `json:"balance,omitempty"`
+	Total                *zoho.Money   `json:"total,omitempty"`
+	PaymentMade          *zoho.Money   `json:"payment_made,omitempty"`
+	CreditsApplied       *zoho.Money   `json:"credits_applied,omitempty"`
+	WriteOffAmount       *zoho.Money   `json:"write_off_amount,omitempty"`
 	CurrencyCode         string        `json:"currency_code,omitempty"`
 	CurrencySymbol       string        `json:"currency_symbol,omitempty"`
 	HasAttachment        bool          `json:"has_attachment,omitempty"`
-	CreatedTime          string        `json:"created_time,omitempty"`
-	UpdatedTime          string        `json:"updated_time,omitempty"`
+	CreatedTime          *zoho.Time    `json:"created_time,omitempty"`
+	UpdatedTime          *zoho.Time    `json:"updated_time,omitempty"`
 	SalespersonID        string        `json:"salesperson_id,omitempty"`
 	SalespersonName      string        `json:"salesperson_name,omitempty"`
 	InvoiceUrl           string        `json:"invoice_url,omitempty"`
-	PaymentExpectedDate  string        `json:"payment_expected_date,omitempty"`
+	PaymentExpectedDate  *zoho.Date    `json:"payment_expected_date,omitempty"`
 	ArchPaymentInitiated interface{}   `json:"ach_payment_initiated,omitempty"` // per documentation this field should be bool, but received empty string
 	TransactionType      string        `json:"transaction_type,omitempty"`
 	InvoiceItems         []InvoiceItem `json:"invoice_items,omitempty"`
@@ -750,40 +750,40 @@ type InvoiceItem struct {
 	Code             string        `json:"code,omitempty"`
 	Tags             []Tag         `json:"tags,omitempty"`
 	ItemCustomFields []CustomField `json:"item_custom_fields,omitempty"`
-	Price            float64       `json:"price,omitempty"`
+	Price            *zoho.Money   `json:"price,omitempty"`
 	Quantity         float64       `json:"quantity,omitempty"`
-	DiscountAmount   float64       `json:"discount_amount,omitempty"`
-	ItemTotal        float64       `json:"item_total,omitempty"`
+	DiscountAmount   *zoho.Money   `json:"discount_amount,omitempty"`
+	ItemTotal        *zoho.Money   `json:"item_total,omitempty"`
 	TaxID            string        `json:"tax_id,omitempty"`
 	TaxExemptionID   string        `json:"tax_exemption_id,omitempty"`
 	TaxExemptionCode string        `json:"tax_exemption_code,omitempty"`
 }
 
 type Coupon struct {
-	CouponCode     string  `json:"coupon_code,omitempty"`
-	CouponName     string  `json:"coupon_name,omitempty"`
-	DiscountAmount float64 `json:"discount_amount,omitempty"`
+	CouponCode     string      `json:"coupon_code,omitempty"`
+	CouponName     string      `json:"coupon_name,omitempty"`
+	DiscountAmount *zoho.Money `json:"discount_amount,omitempty"`
 }
 
 type Credit struct {
-	CreditnoteID      string  `json:"creditnote_id,omitempty"`
-	CreditnotesNumber string  `json:"creditnotes_number,omitempty"`
-	CreditedDate      string  `json:"credited_date,omitempty"`
-	CreditedAmount    float64 `json:"credited_amount,omitempty"`
+	CreditnoteID      string      `json:"creditnote_id,omitempty"`
+	CreditnotesNumber string      `json:"creditnotes_number,omitempty"`
+	CreditedDate      *zoho.Date  `json:"credited_date,omitempty"`
+	CreditedAmount    *zoho.Money `json:"credited_amount,omitempty"`
 }
 
 type Payment struct {
-	PaymentID            string  `json:"payment_id,omitempty"`
-	PaymentMode          string  `json:"payment_mode,omitempty"`
-	InvoicePaymentID     string  `json:"invoice_payment_id,omitempty"`
-	AmountRefunded       float64 `json:"amount_refunded,omitempty"`
-	GatewayTransactionID string  `json:"gateway_transaction_id,omitempty"`
-	Description          string  `json:"description,omitempty"`
-	Date                 string  `json:"date,omitempty"`
-	ReferenceNumber      string  `json:"reference_number,omitempty"`
-	Amount               float64 `json:"amount,omitempty"`
-	BankCharges          float64 `json:"bank_charges,omitempty"`
-	ExchangeRate         float64 `json:"exchange_rate,omitempty"`
+	PaymentID            string      `json:"payment_id,omitempty"`
+	PaymentMode          string      `json:"payment_mode,omitempty"`
+	InvoicePaymentID     string      `json:"invoice_payment_id,omitempty"`
+	AmountRefunded       *zoho.Money `json:"amount_refunded,omitempty"`
+	GatewayTransactionID string      `json:"gateway_transaction_id,omitempty"`
+	Description          string      `json:"description,omitempty"`
+	Date                 *zoho.Date  `json:"date,omitempty"`
+	ReferenceNumber      string      `json:"reference_number,omitempty"`
+	Amount               *zoho.Money `json:"amount,omitempty"`
+	BankCharges          *zoho.Money `json:"bank_charges,omitempty"`
+	ExchangeRate         float64     `json:"exchange_rate,omitempty"`
 }
 
 type Comment struct {
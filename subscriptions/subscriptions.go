@@ -277,19 +277,19 @@ type AddChargeResponse struct {
 	Code    int64  `json:"code"`
 	Message string `json:"message"`
 	Invoice struct {
-		AchPaymentInitiated     bool    `json:"ach_payment_initiated"`
-		Adjustment              float64 `json:"adjustment"`
-		AdjustmentDescription   string  `json:"adjustment_description"`
-		AllowPartialPayments    bool    `json:"allow_partial_payments"`
-		ApproverID              string  `json:"approver_id"`
-		AutoRemindersConfigured bool    `json:"auto_reminders_configured"`
-		Balance                 float64 `json:"balance"`
-		BcyAdjustment           float64 `json:"bcy_adjustment"`
-		BcyDiscountTotal        float64 `json:"bcy_discount_total"`
-		BcyShippingCharge       float64 `json:"bcy_shipping_charge"`
-		BcySubTotal             float64 `json:"bcy_sub_total"`
-		BcyTaxTotal             float64 `json:"bcy_tax_total"`
-		BcyTotal                float64 `json:"bcy_total"`
+		AchPaymentInitiated     bool       `json:"ach_payment_initiated"`
+		Adjustment              zoho.Money `json:"adjustment"`
+		AdjustmentDescription   string     `json:"adjustment_description"`
+		AllowPartialPayments    bool       `json:"allow_partial_payments"`
+		ApproverID              string     `json:"approver_id"`
+		AutoRemindersConfigured bool       `json:"auto_reminders_configured"`
+		Balance                 zoho.Money `json:"balance"`
+		BcyAdjustment           zoho.Money `json:"bcy_adjustment"`
+		BcyDiscountTotal        zoho.Money `json:"bcy_discount_total"`
+		BcyShippingCharge       zoho.Money `json:"bcy_shipping_charge"`
+		BcySubTotal             zoho.Money `json:"bcy_sub_total"`
+		BcyTaxTotal             zoho.Money `json:"bcy_tax_total"`
+		BcyTotal                zoho.Money `json:"bcy_total"`
 		BillingAddress          struct {
 			Address   string `json:"address"`
 			Attention string `json:"attention"`
@@ -302,11 +302,11 @@ type AddChargeResponse struct {
 			Street2   string `json:"street2"`
 			Zip       string `json:"zip"`
 		} `json:"billing_address"`
-		CanEditItems       bool   `json:"can_edit_items"`
-		CanSendInMail      bool   `json:"can_send_in_mail"`
-		CanSendInvoiceSms  bool   `json:"can_send_invoice_sms"`
-		CanSkipPaymentInfo bool   `json:"can_skip_payment_info"`
-		ClientViewedTime   string `json:"client_viewed_time"`
+		CanEditItems       bool      `json:"can_edit_items"`
+		CanSendInMail      bool      `json:"can_send_in_mail"`
+		CanSendInvoiceSms  bool      `json:"can_send_invoice_sms"`
+		CanSkipPaymentInfo bool      `json:"can_skip_payment_info"`
+		ClientViewedTime   zoho.Time `json:"client_viewed_time"`
 		Contactpersons     []struct {
 			ContactpersonID string `json:"contactperson_id"`
 			Email           string `json:"email"`
@@ -316,10 +316,10 @@ type AddChargeResponse struct {
 		} `json:"contactpersons"`
 		Coupons         []Coupon      `json:"coupons"`
 		CreatedByID     string        `json:"created_by_id"`
-		CreatedDate     string        `json:"created_date"`
-		CreatedTime     string        `json:"created_time"`
+		CreatedDate     zoho.Date     `json:"created_date"`
+		CreatedTime     zoho.Time     `json:"created_time"`
 		Credits         []interface{} `json:"credits"`
-		CreditsApplied  float64       `json:"credits_applied"`
+		CreditsApplied  zoho.Money    `json:"credits_applied"`
 		CurrencyCode    string        `json:"currency_code"`
 		CurrencyID      string        `json:"currency_id"`
 		CurrencySymbol  string        `json:"currency_symbol"`
@@ -331,27 +331,27 @@ type AddChargeResponse struct {
 		CustomerCustomFields        []CustomField `json:"customer_custom_fields"`
 		CustomerID                  string        `json:"customer_id"`
 		CustomerName                string        `json:"customer_name"`
-		Date                        string        `json:"date"`
+		Date                        zoho.Date     `json:"date"`
 		DiscountPercent             float64       `json:"discount_percent"`
-		DiscountTotal               float64       `json:"discount_total"`
+		DiscountTotal               zoho.Money    `json:"discount_total"`
 		Documents                   []interface{} `json:"documents"`
-		DueDate                     string        `json:"due_date"`
+		DueDate                     zoho.Date     `json:"due_date"`
 		Email                       string        `json:"email"`
 		ExchangeRate                float64       `json:"exchange_rate"`
 		InprocessTransactionPresent bool          `json:"inprocess_transaction_present"`
-		InvoiceDate                 string        `json:"invoice_date"`
+		InvoiceDate                 zoho.Date     `json:"invoice_date"`
 		InvoiceID                   string        `json:"invoice_id"`
 		InvoiceItems                []struct {
 			AccountID        string        `json:"account_id"`
 			AccountName      string        `json:"account_name"`
 			Code             string        `json:"code"`
 			Description      string        `json:"description"`
-			DiscountAmount   float64       `json:"discount_amount"`
+			DiscountAmount   zoho.Money    `json:"discount_amount"`
 			ItemCustomFields []CustomField `json:"item_custom_fields"`
 			ItemID           string        `json:"item_id"`
-			ItemTotal        float64       `json:"item_total"`
+			ItemTotal        zoho.Money    `json:"item_total"`
 			Name             string        `json:"name"`
-			Price            float64       `json:"price"`
+			Price            zoho.Money    `json:"price"`
 			ProductID        string        `json:"product_id"`
 			ProductType      string        `json:"product_type"`
 			Quantity         float64       `json:"quantity"`
@@ -362,38 +362,38 @@ type AddChargeResponse struct {
 			TaxType          string        `json:"tax_type"`
 			Unit             string        `json:"unit"`
 		} `json:"invoice_items"`
-		InvoiceNumber          string `json:"invoice_number"`
-		InvoiceURL             string `json:"invoice_url"`
-		IsInclusiveTax         bool   `json:"is_inclusive_tax"`
-		IsReverseChargeApplied bool   `json:"is_reverse_charge_applied"`
-		IsViewedByClient       bool   `json:"is_viewed_by_client"`
-		LastModifiedByID       string `json:"last_modified_by_id"`
-		Notes                  string `json:"notes"`
-		Number                 string `json:"number"`
-		PageWidth              string `json:"page_width"`
-		PaymentExpectedDate    string `json:"payment_expected_date"`
+		InvoiceNumber          string    `json:"invoice_number"`
+		InvoiceURL             string    `json:"invoice_url"`
+		IsInclusiveTax         bool      `json:"is_inclusive_tax"`
+		IsReverseChargeApplied bool      `json:"is_reverse_charge_applied"`
+		IsViewedByClient       bool      `json:"is_viewed_by_client"`
+		LastModifiedByID       string    `json:"last_modified_by_id"`
+		Notes                  string    `json:"notes"`
+		Number                 string    `json:"number"`
+		PageWidth              string    `json:"page_width"`
+		PaymentExpectedDate    zoho.Date `json:"payment_expected_date"`
 		PaymentGateways        []struct {
 			PaymentGateway string `json:"payment_gateway"`
 		} `json:"payment_gateways"`
-		PaymentMade            float64 `json:"payment_made"`
-		PaymentReminderEnabled bool    `json:"payment_reminder_enabled"`
-		PaymentTerms           int64   `json:"payment_terms"`
-		PaymentTermsLabel      string  `json:"payment_terms_label"`
+		PaymentMade            zoho.Money `json:"payment_made"`
+		PaymentReminderEnabled bool       `json:"payment_reminder_enabled"`
+		PaymentTerms           int64      `json:"payment_terms"`
+		PaymentTermsLabel      string     `json:"payment_terms_label"`
 		Payments               []struct {
-			Amount               float64 `json:"amount"`
-			AmountRefunded       float64 `json:"amount_refunded"`
-			BankCharges          float64 `json:"bank_charges"`
-			CardType             string  `json:"card_type"`
-			Date                 string  `json:"date"`
-			Description          string  `json:"description"`
-			ExchangeRate         float64 `json:"exchange_rate"`
-			GatewayTransactionID string  `json:"gateway_transaction_id"`
-			InvoicePaymentID     string  `json:"invoice_payment_id"`
-			LastFourDigits       string  `json:"last_four_digits"`
-			PaymentID            string  `json:"payment_id"`
-			PaymentMode          string  `json:"payment_mode"`
-			ReferenceNumber      string  `json:"reference_number"`
-			SettlementStatus     string  `json:"settlement_status"`
+			Amount               zoho.Money `json:"amount"`
+			AmountRefunded       zoho.Money `json:"amount_refunded"`
+			BankCharges          zoho.Money `json:"bank_charges"`
+			CardType             string     `json:"card_type"`
+			Date                 zoho.Date  `json:"date"`
+			Description          string     `json:"description"`
+			ExchangeRate         float64    `json:"exchange_rate"`
+			GatewayTransactionID string     `json:"gateway_transaction_id"`
+			InvoicePaymentID     string     `json:"invoice_payment_id"`
+			LastFourDigits       string     `json:"last_four_digits"`
+			PaymentID            string     `json:"payment_id"`
+			PaymentMode          string     `json:"payment_mode"`
+			ReferenceNumber      string     `json:"reference_number"`
+			SettlementStatus     string     `json:"settlement_status"`
 		} `json:"payments"`
 		PricePrecision  int64  `json:"price_precision"`
 		PricebookID     string `json:"pricebook_id"`
@@ -413,42 +413,42 @@ type AddChargeResponse struct {
 			Street2   string `json:"street2"`
 			Zip       string `json:"zip"`
 		} `json:"shipping_address"`
-		ShippingCharge                        float64 `json:"shipping_charge"`
-		ShippingChargeExclusiveOfTax          float64 `json:"shipping_charge_exclusive_of_tax"`
-		ShippingChargeExclusiveOfTaxFormatted string  `json:"shipping_charge_exclusive_of_tax_formatted"`
-		ShippingChargeInclusiveOfTax          float64 `json:"shipping_charge_inclusive_of_tax"`
-		ShippingChargeInclusiveOfTaxFormatted string  `json:"shipping_charge_inclusive_of_tax_formatted"`
-		ShippingChargeTax                     string  `json:"shipping_charge_tax"`
-		ShippingChargeTaxFormatted            string  `json:"shipping_charge_tax_formatted"`
-		ShippingChargeTaxID                   string  `json:"shipping_charge_tax_id"`
-		ShippingChargeTaxName                 string  `json:"shipping_charge_tax_name"`
-		ShippingChargeTaxPercentage           string  `json:"shipping_charge_tax_percentage"`
-		ShippingChargeTaxType                 string  `json:"shipping_charge_tax_type"`
-		Status                                string  `json:"status"`
-		StopReminderUntilPaymentExpectedDate  bool    `json:"stop_reminder_until_payment_expected_date"`
-		SubTotal                              float64 `json:"sub_total"`
-		SubmitterID                           string  `json:"submitter_id"`
+		ShippingCharge                        zoho.Money `json:"shipping_charge"`
+		ShippingChargeExclusiveOfTax          zoho.Money `json:"shipping_charge_exclusive_of_tax"`
+		ShippingChargeExclusiveOfTaxFormatted string     `json:"shipping_charge_exclusive_of_tax_formatted"`
+		ShippingChargeInclusiveOfTax          zoho.Money `json:"shipping_charge_inclusive_of_tax"`
+		ShippingChargeInclusiveOfTaxFormatted string     `json:"shipping_charge_inclusive_of_tax_formatted"`
+		ShippingChargeTax                     string     `json:"shipping_charge_tax"`
+		ShippingChargeTaxFormatted            string     `json:"shipping_charge_tax_formatted"`
+		ShippingChargeTaxID                   string     `json:"shipping_charge_tax_id"`
+		ShippingChargeTaxName                 string     `json:"shipping_charge_tax_name"`
+		ShippingChargeTaxPercentage           string     `json:"shipping_charge_tax_percentage"`
+		ShippingChargeTaxType                 string     `json:"shipping_charge_tax_type"`
+		Status                                string     `json:"status"`
+		StopReminderUntilPaymentExpectedDate  bool       `json:"stop_reminder_until_payment_expected_date"`
+		SubTotal                              zoho.Money `json:"sub_total"`
+		SubmitterID                           string     `json:"submitter_id"`
 		Subscriptions                         []struct {
 			SubscriptionID string `json:"subscription_id"`
 		} `json:"subscriptions"`
 		TaxRounding                   string        `json:"tax_rounding"`
-		TaxTotal                      float64       `json:"tax_total"`
+		TaxTotal                      zoho.Money    `json:"tax_total"`
 		Taxes                         []interface{} `json:"taxes"`
 		TemplateID                    string        `json:"template_id"`
 		TemplateName                  string        `json:"template_name"`
 		TemplateType                  string        `json:"template_type"`
 		Terms                         string        `json:"terms"`
-		Total                         float64       `json:"total"`
+		Total                         zoho.Money    `json:"total"`
 		TransactionType               string        `json:"transaction_type"`
 		UnbilledChargesID             string        `json:"unbilled_charges_id"`
-		UnusedCreditsReceivableAmount float64       `json:"unused_credits_receivable_amount"`
-		UpdatedTime                   string        `json:"updated_time"`
+		UnusedCreditsReceivableAmount zoho.Money    `json:"unused_credits_receivable_amount"`
+		UpdatedTime                   zoho.Time     `json:"updated_time"`
 		VatTreatment                  string        `json:"vat_treatment"`
-		WriteOffAmount                float64       `json:"write_off_amount"`
+		WriteOffAmount                zoho.Money    `json:"write_off_amount"`
 		ZcrmPotentialID               string        `json:"zcrm_potential_id"`
 	} `json:"invoice"`
 	UnbilledCharge struct {
-		Balance        float64 `json:"balance"`
+		Balance        zoho.Money `json:"balance"`
 		BillingAddress struct {
 			Attention string `json:"attention"`
 			City      string `json:"city"`
@@ -460,10 +460,10 @@ type AddChargeResponse struct {
 			Street2   string `json:"street2"`
 			Zip       string `json:"zip"`
 		} `json:"billing_address"`
-		Coupons         []Coupon `json:"coupons"`
-		CreatedTime     string   `json:"created_time"`
-		CurrencyCode    string   `json:"currency_code"`
-		CurrencySymbol  string   `json:"currency_symbol"`
+		Coupons         []Coupon  `json:"coupons"`
+		CreatedTime     zoho.Time `json:"created_time"`
+		CurrencyCode    string    `json:"currency_code"`
+		CurrencySymbol  string    `json:"currency_symbol"`
 		CustomFieldHash struct {
 		} `json:"custom_field_hash"`
 		CustomFields            []CustomField `json:"custom_fields"`
@@ -492,34 +492,34 @@ type AddChargeResponse struct {
 			Zip       string `json:"zip"`
 		} `json:"shipping_address"`
 		Status              string        `json:"status"`
-		SubTotal            float64       `json:"sub_total"`
+		SubTotal            zoho.Money    `json:"sub_total"`
 		SubscriptionID      string        `json:"subscription_id"`
 		TaxRounding         string        `json:"tax_rounding"`
-		TaxTotal            float64       `json:"tax_total"`
+		TaxTotal            zoho.Money    `json:"tax_total"`
 		Taxes               []interface{} `json:"taxes"`
-		Total               float64       `json:"total"`
+		Total               zoho.Money    `json:"total"`
 		TransactionType     string        `json:"transaction_type"`
-		UnbilledChargeDate  string        `json:"unbilled_charge_date"`
+		UnbilledChargeDate  zoho.Date     `json:"unbilled_charge_date"`
 		UnbilledChargeID    string        `json:"unbilled_charge_id"`
 		UnbilledChargeItems []struct {
-			AccountID            string  `json:"account_id"`
-			Code                 string  `json:"code"`
-			Description          string  `json:"description"`
-			DiscountAmount       float64 `json:"discount_amount"`
-			ItemTotal            float64 `json:"item_total"`
-			Name                 string  `json:"name"`
-			Price                float64 `json:"price"`
-			ProductID            string  `json:"product_id"`
-			ProductType          string  `json:"product_type"`
-			Quantity             float64 `json:"quantity"`
-			TaxID                string  `json:"tax_id"`
-			TaxName              string  `json:"tax_name"`
-			TaxPercentage        float64 `json:"tax_percentage"`
-			TaxType              string  `json:"tax_type"`
-			UnbilledChargeItemID string  `json:"unbilled_charge_item_id"`
+			AccountID            string     `json:"account_id"`
+			Code                 string     `json:"code"`
+			Description          string     `json:"description"`
+			DiscountAmount       zoho.Money `json:"discount_amount"`
+			ItemTotal            zoho.Money `json:"item_total"`
+			Name                 string     `json:"name"`
+			Price                zoho.Money `json:"price"`
+			ProductID            string     `json:"product_id"`
+			ProductType          string     `json:"product_type"`
+			Quantity             float64    `json:"quantity"`
+			TaxID                string     `json:"tax_id"`
+			TaxName              string     `json:"tax_name"`
+			TaxPercentage        float64    `json:"tax_percentage"`
+			TaxType              string     `json:"tax_type"`
+			UnbilledChargeItemID string     `json:"unbilled_charge_item_id"`
 		} `json:"unbilled_charge_items"`
-		UnusedCreditsReceivableAmount float64 `json:"unused_credits_receivable_amount"`
-		UpdatedTime                   string  `json:"updated_time"`
+		UnusedCreditsReceivableAmount zoho.Money `json:"unused_credits_receivable_amount"`
+		UpdatedTime                   zoho.Time  `json:"updated_time"`
 	} `json:"unbilled_charge"`
 }
 
@@ -580,14 +580,14 @@ type SubscriptionCreate struct {
 	Contactpersons    []struct {
 		ContactpersonID string `json:"contactperson_id,omitempty"`
 	} `json:"contactpersons,omitempty"`
-	StartsAt      string `json:"starts_at,omitempty"`
-	ExchangeRate  int64  `json:"exchange_rate,omitempty"`
-	PlaceOfSupply string `json:"place_of_supply,omitempty"`
+	StartsAt      *zoho.Date `json:"starts_at,omitempty"`
+	ExchangeRate  int64      `json:"exchange_rate,omitempty"`
+	PlaceOfSupply string     `json:"place_of_supply,omitempty"`
 	Plan          struct {
 		PlanCode                 string        `json:"plan_code,omitempty"`
 		PlanDescription          string        `json:"plan_description,omitempty"`
-		Price                    float64       `json:"price,omitempty"`
-		SetupFee                 float64       `json:"setup_fee,omitempty"`
+		Price                    *zoho.Money   `json:"price,omitempty"`
+		SetupFee                 *zoho.Money   `json:"setup_fee,omitempty"`
 		SetupFeeTaxID            string        `json:"setup_fee_tax_id,omitempty"`
 		Tags                     []Tag         `json:"tags,omitempty"`
 		ItemCustomFields         []CustomField `json:"item_custom_fields,omitempty"`
@@ -604,7 +604,7 @@ type SubscriptionCreate struct {
 	Addons []struct {
 		AddonCode        string        `json:"addon_code,omitempty"`
 		AddonDescription string        `json:"addon_description,omitempty"`
-		Price            float64       `json:"price,omitempty"`
+		Price            *zoho.Money   `json:"price,omitempty"`
 		Tags             []Tag         `json:"tags,omitempty"`
 		ItemCustomFields []CustomField `json:"item_custom_fields,omitempty"`
 		TaxExemptionID   string        `json:"tax_exemption_id,omitempty"`
@@ -624,8 +624,8 @@ type SubscriptionUpdate struct {
 	Plan         struct {
 		PlanCode                 string        `json:"plan_code,omitempty"`
 		PlanDescription          string        `json:"plan_description,omitempty"`
-		Price                    float64       `json:"price,omitempty"`
-		SetupFee                 float64       `json:"setup_fee,omitempty"`
+		Price                    *zoho.Money   `json:"price,omitempty"`
+		SetupFee                 *zoho.Money   `json:"setup_fee,omitempty"`
 		Quantity                 float64       `json:"quantity,omitempty"`
 		Tags                     []Tag         `json:"tags,omitempty"`
 		ItemCustomFields         []CustomField `json:"item_custom_fields,omitempty"`
@@ -642,7 +642,7 @@ type SubscriptionUpdate struct {
 	Addons []struct {
 		AddonCode        string        `json:"addon_code,omitempty"`
 		AddonDescription string        `json:"addon_description,omitempty"`
-		Price            float64       `json:"price,omitempty"`
+		Price            *zoho.Money   `json:"price,omitempty"`
 		Tags             []Tag         `json:"tags,omitempty"`
 		ItemCustomFields []CustomField `json:"item_custom_fields,omitempty"`
 		TaxExemptionID   string        `json:"tax_exemption_id,omitempty"`
@@ -665,7 +665,7 @@ type SubscriptionUpdate struct {
 }
 
 type SubscriptionAddCharge struct {
-	Amount               float64       `json:"amount,omitempty"`
+	Amount               *zoho.Money   `json:"amount,omitempty"`
 	Description          string        `json:"description,omitempty"`
 	Tags                 []Tag         `json:"tags,omitempty"`
 	ItemCustomFields     []CustomField `json:"item_custom_fields,omitempty"`
@@ -674,36 +674,36 @@ type SubscriptionAddCharge struct {
 }
 
 type Subscription struct {
-	SubscriptionID      string  `json:"subscription_id,omitempty"`
-	Name                string  `json:"name,omitempty"`
-	Status              string  `json:"status,omitempty"`
-	Amount              float64 `json:"amount,omitempty"`
-	CreatedAt           string  `json:"created_at,omitempty"`
-	ActivatedAt         string  `json:"activated_at,omitempty"`
-	CurrentTermStartsAt string  `json:"current_term_starts_at,omitempty"`
-	CurrentTermEndsAt   string  `json:"current_term_ends_at,omitempty"`
-	LastBillingAt       string  `json:"last_billing_at,omitempty"`
-	NextBillingAt       string  `json:"next_billing_at,omitempty"`
-	ExpiresAt           string  `json:"expires_at,omitempty"`
-	Interval            int64   `json:"interval,omitempty"`
-	IntervalUnit        string  `json:"interval_unit,omitempty"`
-	AutoCollect         bool    `json:"auto_collect,omitempty"`
-	CreatedTime         string  `json:"created_time,omitempty"`
-	UpdatedTime         string  `json:"updated_time,omitempty"`
-	ReferenceID         string  `json:"reference_id,omitempty"`
-	SalespersonID       string  `json:"salesperson_id,omitempty"`
-	SalespersonName     string  `json:"salesperson_name,omitempty"`
-	ChildInvoiceID      string  `json:"child_invoice_id,omitempty"`
-	CurrencyCode        string  `json:"currency_code,omitempty"`
-	CurrencySymbol      string  `json:"currency_symbol,omitempty"`
-	EndOfTerm           bool    `json:"end_of_term,omitempty"`
-	ProductID           string  `json:"product_id,omitempty"`
-	ProductName         string  `json:"product_name,omitempty"`
-	Plan                Plan    `json:"plan,omitempty"`
-	Addons              []Addon `json:"addons,omitempty"`
+	SubscriptionID      string      `json:"subscription_id,omitempty"`
+	Name                string      `json:"name,omitempty"`
+	Status              string      `json:"status,omitempty"`
+	Amount              *zoho.Money `json:"amount,omitempty"`
+	CreatedAt           *zoho.Date  `json:"created_at,omitempty"`
+	ActivatedAt         *zoho.Date  `json:"activated_at,omitempty"`
+	CurrentTermStartsAt *zoho.Date  `json:"current_term_starts_at,omitempty"`
+	CurrentTermEndsAt   *zoho.Date  `json:"current_term_ends_at,omitempty"`
+	LastBillingAt       *zoho.Date  `json:"last_billing_at,omitempty"`
+	NextBillingAt       *zoho.Date  `json:"next_billing_at,omitempty"`
+	ExpiresAt           *zoho.Date  `json:"expires_at,omitempty"`
+	Interval            int64       `json:"interval,omitempty"`
+	IntervalUnit        string      `json:"interval_unit,omitempty"`
+	AutoCollect         bool        `json:"auto_collect,omitempty"`
+	CreatedTime         *zoho.Time  `json:"created_time,omitempty"`
+	UpdatedTime         *zoho.Time  `json:"updated_time,omitempty"`
+	ReferenceID         string      `json:"reference_id,omitempty"`
+	SalespersonID       string      `json:"salesperson_id,omitempty"`
+	SalespersonName     string      `json:"salesperson_name,omitempty"`
+	ChildInvoiceID      string      `json:"child_invoice_id,omitempty"`
+	CurrencyCode        string      `json:"currency_code,omitempty"`
+	CurrencySymbol      string      `json:"currency_symbol,omitempty"`
+	EndOfTerm           bool        `json:"end_of_term,omitempty"`
+	ProductID           string      `json:"product_id,omitempty"`
+	ProductName         string      `json:"product_name,omitempty"`
+	Plan                Plan        `json:"plan,omitempty"`
+	Addons              []Addon     `json:"addons,omitempty"`
 	Coupon              struct {
-		CouponCode     string  `json:"coupon_code,omitempty"`
-		DiscountAmount float64 `json:"discount_amount,omitempty"`
+		CouponCode     string      `json:"coupon_code,omitempty"`
+		DiscountAmount *zoho.Money `json:"discount_amount,omitempty"`
 	} `json:"coupon,omitempty"`
 	Card struct {
 		CardID         string `json:"card_id,omitempty"`
@@ -719,10 +719,10 @@ type Subscription struct {
 	CustomFields      []CustomField   `json:"custom_fields,omitempty"`
 	Contactpersons    []ContactPerson `json:"contactpersons,omitempty"`
 	Notes             []struct {
-		NoteID        string `json:"note_id,omitempty"`
-		Description   string `json:"description,omitempty"`
-		CommentedBy   string `json:"commented_by,omitempty"`
-		CommentedTime string `json:"commented_time,omitempty"`
+		NoteID        string     `json:"note_id,omitempty"`
+		Description   string     `json:"description,omitempty"`
+		CommentedBy   string     `json:"commented_by,omitempty"`
+		CommentedTime *zoho.Time `json:"commented_time,omitempty"`
 	} `json:"notes,omitempty"`
 	PaymentGateways        []PaymentGateway `json:"payment_gateways,omitempty"`
 	CreateBackdatedInvoice bool             `json:"create_backdated_invoice,omitempty"`
@@ -730,27 +730,27 @@ type Subscription struct {
 }
 
 type Addon struct {
-	AddonCode        string  `json:"addon_code,omitempty"`
-	Name             string  `json:"name,omitempty"`
-	AddonDescription string  `json:"addon_description,omitempty"`
-	Quantity         float64 `json:"quantity,omitempty"`
-	Price            float64 `json:"price,omitempty"`
-	Discount         float64 `json:"discount,omitempty"`
-	Total            float64 `json:"total,omitempty"`
-	TaxID            string  `json:"tax_id,omitempty"`
+	AddonCode        string      `json:"addon_code,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	AddonDescription string      `json:"addon_description,omitempty"`
+	Quantity         float64     `json:"quantity,omitempty"`
+	Price            *zoho.Money `json:"price,omitempty"`
+	Discount         float64     `json:"discount,omitempty"`
+	Total            *zoho.Money `json:"total,omitempty"`
+	TaxID            string      `json:"tax_id,omitempty"`
 }
 
 type Plan struct {
-	PlanCode        string  `json:"plan_code,omitempty"`
-	Name            string  `json:"name,omitempty"`
-	Quantity        float64 `json:"quantity,omitempty"`
-	Price           float64 `json:"price,omitempty"`
-	Discount        float64 `json:"discount,omitempty"`
-	Total           float64 `json:"total,omitempty"`
-	SetupFee        float64 `json:"setup_fee,omitempty"`
-	PlanDescription string  `json:"plan_description,omitempty"`
-	TaxID           string  `json:"tax_id,omitempty"`
-	TrialDays       int64   `json:"trial_days,omitempty"`
+	PlanCode        string      `json:"plan_code,omitempty"`
+	Name            string      `json:"name,omitempty"`
+	Quantity        float64     `json:"quantity,omitempty"`
+	Price           *zoho.Money `json:"price,omitempty"`
+	Discount        float64     `json:"discount,omitempty"`
+	Total           *zoho.Money `json:"total,omitempty"`
+	SetupFee        *zoho.Money `json:"setup_fee,omitempty"`
+	PlanDescription string      `json:"plan_description,omitempty"`
+	TaxID           string      `json:"tax_id,omitempty"`
+	TrialDays       int64       `json:"trial_days,omitempty"`
 }
 
 type Customer struct {
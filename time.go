@@ -1,63 +1,150 @@
 package zoho
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 )
 
-// Time is a time.Time which can be marshalled/unmarshalled according to Zoho's specific time scheme
+// Time is a time.Time which can be marshalled/unmarshalled according to Zoho's specific time scheme.
+// Every format returned by Zoho products is accepted (offset, Z, milliseconds, epoch, date-only,
+// empty and null), and the value is marshalled to the second with the numeric offset of its
+// timezone, such as "2019-05-09T15:19:13+05:30" or "2019-05-09T09:49:13+00:00".
 type Time time.Time
 
+// zohoTimeLayout is the layout Time is marshalled with, the tolerant timeLayouts only apply to input
 var zohoTimeLayout = "2006-01-02T15:04:05-07:00"
 
+// timeLayouts are the formats tried in order when unmarshalling a Time or Date
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"02-Jan-2006 15:04:05", // Bookings
+	zohoDateLayout,
+	"02-Jan-2006",
+}
+
 // MarshalJSON is the json marshalling function for Time internal type
-func (t *Time) MarshalJSON() ([]byte, error) {
-	if *t == Time(time.Time{}) {
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
 		return []byte("null"), nil
 	}
-	stamp := fmt.Sprintf("\"%s\"", time.Time(*t).Format(zohoTimeLayout))
+	stamp := fmt.Sprintf("\"%s\"", time.Time(t).Format(zohoTimeLayout))
 	return []byte(stamp), nil
 }
 
 // UnmarshalJSON is the json unmarshalling function for Time internal type
 func (t *Time) UnmarshalJSON(b []byte) error {
-	s := strings.Trim(string(b), "\"")
-	if s == "null" {
-		*t = Time(time.Time{})
-		return nil
+	pTime, err := parseTime(b)
+	if err != nil {
+		return fmt.Errorf("Failed to parse zoho.Time from JSON: %s", err)
 	}
-	pTime, err := time.Parse(zohoTimeLayout, s)
-	if err == nil {
-		*t = Time(pTime)
+	*t = Time(pTime)
+	return nil
+}
+
+// Std returns the time.Time of t
+func (t Time) Std() time.Time {
+	return time.Time(t)
+}
+
+// IsZero reports whether t is unset
+func (t Time) IsZero() bool {
+	return time.Time(t).IsZero()
+}
+
+// String returns t formatted according to Zoho's time scheme
+func (t Time) String() string {
+	if t.IsZero() {
+		return ""
 	}
-	return err
+	return time.Time(t).Format(zohoTimeLayout)
 }
 
-// Date iis a time.Time which can be marshalled/unmarshalled according to Zoho's specific date scheme
+// Date iis a time.Time which can be marshalled/unmarshalled according to Zoho's specific date scheme,
+// timestamps are accepted when unmarshalling and the date is taken in their own timezone
 type Date time.Time
 
 var zohoDateLayout = "2006-01-02"
 
 // MarshalJSON is the json marshalling function for Date internal type
-func (d *Date) MarshalJSON() ([]byte, error) {
-	if *d == Date(time.Time{}) {
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
 		return []byte("null"), nil
 	}
-	stamp := fmt.Sprintf("\"%s\"", time.Time(*d).Format(zohoDateLayout))
+	stamp := fmt.Sprintf("\"%s\"", time.Time(d).Format(zohoDateLayout))
 	return []byte(stamp), nil
 }
 
 // UnmarshalJSON is the json unmarshalling function for Date internal type
 func (d *Date) UnmarshalJSON(b []byte) error {
-	s := strings.Trim(string(b), "\"")
-	if s == "null" {
-		*d = Date(time.Time{})
-		return nil
+	pTime, err := parseTime(b)
+	if err != nil {
+		return fmt.Errorf("Failed to parse zoho.Date from JSON: %s", err)
+	}
+	if !pTime.IsZero() {
+		y, m, day := pTime.Date()
+		pTime = time.Date(y, m, day, 0, 0, 0, 0, pTime.Location())
 	}
-	pTime, err := time.Parse(zohoDateLayout, s)
-	if err == nil {
-		*d = Date(pTime)
+	*d = Date(pTime)
+	return nil
+}
+
+// Std returns the time.Time of d, at midnight
+func (d Date) Std() time.Time {
+	return time.Time(d)
+}
+
+// IsZero reports whether d is unset
+func (d Date) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+// String returns d formatted according to Zoho's date scheme
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return time.Time(d).Format(zohoDateLayout)
+}
+
+// parseTime decodes the JSON value of a time or date in any of the formats used by Zoho,
+// empty strings and null are the zero time
+func parseTime(b []byte) (time.Time, error) {
+	raw := strings.TrimSpace(string(b))
+	if raw == "null" || raw == "" {
+		return time.Time{}, nil
+	}
+
+	s := raw
+	if strings.HasPrefix(raw, "\"") {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return time.Time{}, err
+		}
+		s = strings.TrimSpace(s)
+	}
+	if s == "" || s == "null" {
+		return time.Time{}, nil
+	}
+
+	// Epoch timestamps, in milliseconds when too large to be seconds
+	if epoch, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if epoch > 1e11 || epoch < -1e11 {
+			return time.Unix(0, epoch*int64(time.Millisecond)).UTC(), nil
+		}
+		return time.Unix(epoch, 0).UTC(), nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
 	}
-	return err
+	return time.Time{}, fmt.Errorf("unknown time format %q", s)
 }
@@ -0,0 +1,125 @@
+package zoho
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	ist := time.FixedZone("", 5*3600+1800)
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: `1557388010`, want: time.Unix(1557388010, 0).UTC()},
+		{in: `"1557388010"`, want: time.Unix(1557388010, 0).UTC()},
+		{in: `1557388010870`, want: time.Unix(1557388010, 870*int64(time.Millisecond)).UTC()},
+		{in: `"2019-05-09"`, want: time.Date(2019, 5, 9, 0, 0, 0, 0, time.UTC)},
+		{in: `"2019-05-09T15:19:13+05:30"`, want: time.Date(2019, 5, 9, 15, 19, 13, 0, ist)},
+		{in: `"2019-05-09T15:19:13+0530"`, want: time.Date(2019, 5, 9, 15, 19, 13, 0, ist)},
+		{
+			in:   `"2019-05-09T15:19:13.250+0530"`,
+			want: time.Date(2019, 5, 9, 15, 19, 13, 250*int(time.Millisecond), ist),
+		},
+		{in: `"2019-05-09T09:49:13Z"`, want: time.Date(2019, 5, 9, 9, 49, 13, 0, time.UTC)},
+		{
+			in:   `"2019-05-09T09:49:13.5Z"`,
+			want: time.Date(2019, 5, 9, 9, 49, 13, 500*int(time.Millisecond), time.UTC),
+		},
+		{in: `"2019-05-09 09:49:13"`, want: time.Date(2019, 5, 9, 9, 49, 13, 0, time.UTC)},
+		{in: `"2019-05-09 09:49"`, want: time.Date(2019, 5, 9, 9, 49, 0, 0, time.UTC)},
+		{in: `"09-May-2019 09:49:13"`, want: time.Date(2019, 5, 9, 9, 49, 13, 0, time.UTC)},
+		{in: `"09-May-2019"`, want: time.Date(2019, 5, 9, 0, 0, 0, 0, time.UTC)},
+		{in: `""`, want: time.Time{}},
+		{in: `" "`, want: time.Time{}},
+		{in: `null`, want: time.Time{}},
+		{in: `"null"`, want: time.Time{}},
+	}
+
+	for _, tt := range tests {
+		got, err := parseTime([]byte(tt.in))
+		if err != nil {
+			t.Errorf("parseTime(%s) returned error: %s", tt.in, err)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("parseTime(%s) = %s, want %s", tt.in, got, tt.want)
+		}
+		_, gotOffset := got.Zone()
+		_, wantOffset := tt.want.Zone()
+		if gotOffset != wantOffset {
+			t.Errorf("parseTime(%s) offset = %d, want %d", tt.in, gotOffset, wantOffset)
+		}
+	}
+
+	for _, in := range []string{`"yesterday"`, `"09/05/2019"`, `true`, `"2019-05-09T`} {
+		if got, err := parseTime([]byte(in)); err == nil {
+			t.Errorf("parseTime(%s) = %s, want error", in, got)
+		}
+	}
+}
+
+func TestTimeMarshalJSON(t *testing.T) {
+	ist := time.FixedZone("", 5*3600+1800)
+	tests := []struct {
+		in   Time
+		want string
+	}{
+		{in: Time(time.Date(2019, 5, 9, 15, 19, 13, 0, ist)), want: `"2019-05-09T15:19:13+05:30"`},
+		{
+			in:   Time(time.Date(2019, 5, 9, 9, 49, 13, 123456789, time.UTC)),
+			want: `"2019-05-09T09:49:13+00:00"`,
+		},
+		{in: Time{}, want: `null`},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.in)
+		if err != nil {
+			t.Errorf("Marshal(%s) returned error: %s", tt.in.Std(), err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("Marshal(%s) = %s, want %s", tt.in.Std(), b, tt.want)
+		}
+	}
+
+	// The timezone of the decoded value is kept
+	var tm Time
+	if err := json.Unmarshal([]byte(`"2019-05-09T15:19:13+05:30"`), &tm); err != nil {
+		t.Fatalf("Unmarshal returned error: %s", err)
+	}
+	if b, _ := json.Marshal(tm); string(b) != `"2019-05-09T15:19:13+05:30"` {
+		t.Errorf("round trip = %s, want \"2019-05-09T15:19:13+05:30\"", b)
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: `"2019-05-09"`, want: `"2019-05-09"`},
+		{in: `"2019-05-09T23:30:00+05:30"`, want: `"2019-05-09"`},
+		{in: `1557388010`, want: `"2019-05-09"`},
+		{in: `""`, want: `null`},
+		{in: `null`, want: `null`},
+	}
+
+	for _, tt := range tests {
+		var d Date
+		if err := json.Unmarshal([]byte(tt.in), &d); err != nil {
+			t.Errorf("Unmarshal(%s) returned error: %s", tt.in, err)
+			continue
+		}
+		b, err := json.Marshal(d)
+		if err != nil {
+			t.Errorf("Marshal(%s) returned error: %s", tt.in, err)
+			continue
+		}
+		if string(b) != tt.want {
+			t.Errorf("round trip of %s = %s, want %s", tt.in, b, tt.want)
+		}
+	}
+}